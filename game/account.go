@@ -0,0 +1,94 @@
+package game
+
+import (
+	"errors"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Accounts: a captain is one users row that keeps its scores, progress and
+// ratings between sessions. A PIN is optional; students who share a computer can
+// set one so nobody else plays under their name.
+
+// PINMaxLen is the longest PIN or password accepted
+const PINMaxLen = 16
+
+// ErrWrongPIN is returned when a PIN does not unlock an account
+var ErrWrongPIN = errors.New("wrong PIN")
+
+// Account is a captain a returning player can pick
+type Account struct {
+	ID      int64
+	Name    string
+	PINHash string // empty if the account has no PIN
+	Battles int
+	Score   int
+}
+
+// HasPIN reports whether the account is protected by a PIN
+func (a Account) HasPIN() bool {
+	return a.PINHash != ""
+}
+
+// Unlock checks the PIN of the account; accounts without one unlock with any PIN
+func (a Account) Unlock(pin string) error {
+	if !a.HasPIN() {
+		return nil
+	}
+	if bcrypt.CompareHashAndPassword([]byte(a.PINHash), []byte(pin)) != nil {
+		return ErrWrongPIN
+	}
+	return nil
+}
+
+// HashPIN returns a salted hash of the PIN to store with the account
+func HashPIN(pin string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(pin), bcrypt.DefaultCost)
+	return string(hash), err
+}
+
+// SameName reports whether two captain names are the same, ignoring case and
+// surrounding spaces
+func SameName(a, b string) bool {
+	return strings.EqualFold(strings.TrimSpace(a), strings.TrimSpace(b))
+}
+
+// CreateAccount adds a captain, with a PIN unless pin is empty
+func CreateAccount(s Store, name, pin string) (Account, error) {
+	a := Account{Name: name}
+	if pin != "" {
+		hash, err := HashPIN(pin)
+		if err != nil {
+			return a, err
+		}
+		a.PINHash = hash
+	}
+	id, err := s.InsertUser(name)
+	if err != nil {
+		return a, err
+	}
+	a.ID = id
+	if a.HasPIN() {
+		if err := s.SetUserPIN(id, a.PINHash); err != nil {
+			return a, err
+		}
+	}
+	return a, nil
+}
+
+// SeatAccount returns the account for a name typed into a local seat, like the
+// second captain of a versus match. There is no PIN prompt there, so it is the
+// most played account of that name without a PIN, or a new one.
+func SeatAccount(s Store, name string) (int64, error) {
+	accounts, err := s.FindUsers(name)
+	if err != nil {
+		return 0, err
+	}
+	for _, a := range accounts {
+		if !a.HasPIN() {
+			return a.ID, nil
+		}
+	}
+	return s.InsertUser(name)
+}
@@ -0,0 +1,71 @@
+package game
+
+import (
+	"fmt"
+	"hash/fnv"
+	"time"
+)
+
+// The attempt log keeps every answer of every battle, so teachers can see which
+// questions a class gets wrong, how long students take, and when aids were used.
+// A battle's answers are written together when it ends.
+
+// QuestionID identifies a question in the attempt log. It is derived from the
+// subject and text, so it stays the same across sessions and machines.
+func QuestionID(subject, text string) string {
+	h := fnv.New64a()
+	h.Write([]byte(subject))
+	h.Write([]byte{0})
+	h.Write([]byte(text))
+	return fmt.Sprintf("%016x", h.Sum64())
+}
+
+// Battle is a finished battle whose answers go in the attempt log
+type Battle struct {
+	UserID     int64
+	Mode       Mode
+	Subject    string // the subject picked for the battle; each answer keeps its own
+	Difficulty string
+}
+
+// fill gives an answer the battle's subject and difficulty if it has none, and
+// its question ID
+func (b Battle) fill(r AnswerRecord) AnswerRecord {
+	if r.Subject == "" {
+		r.Subject = b.Subject
+	}
+	if r.Difficulty == "" {
+		r.Difficulty = b.Difficulty
+	}
+	if r.QuestionID == "" {
+		r.QuestionID = QuestionID(r.Subject, r.Question)
+	}
+	return r
+}
+
+// Attempt is one logged answer
+type Attempt struct {
+	BattleID int64 // 0 for answers logged before battles were
+	UserID   int64
+	AnswerRecord
+}
+
+// QuestionStats sums up the attempts at one question
+type QuestionStats struct {
+	QuestionID  string
+	Subject     string
+	Question    string
+	Attempts    int
+	Correct     int
+	TimedOut    int
+	HintsUsed   int
+	AvgResponse time.Duration
+}
+
+// Accuracy returns the percentage of attempts answered correctly
+func (s QuestionStats) Accuracy() int {
+	if s.Attempts == 0 {
+		return 0
+	}
+	return s.Correct * 100 / s.Attempts
+}
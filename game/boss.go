@@ -0,0 +1,160 @@
+package game
+
+import "time"
+
+// BossPhase is one stage of a boss battle. A phase starts once the boss's
+// HP drops to its Threshold.
+type BossPhase struct {
+	Name            string
+	Threshold       int
+	DamageReduction float64       // reduction applied to the player's hits
+	TimeLimit       time.Duration // time to answer each question
+	Attack          string        // special attack name, "" for none
+	AttackEvery     int           // the special attack fires every N questions
+	AttackDrain     int           // shields knocked out by the special attack
+}
+
+// Boss guards the end of a difficulty tier; beating it unlocks the next tier
+type Boss struct {
+	Name      string
+	Intro     string
+	Sprite    string
+	MaxHP     int
+	HitDamage int // damage of a correct answer before combo and phase reduction
+	Questions int // questions drawn from every subject
+	Phases    []BossPhase
+}
+
+// One boss per level (Easy..Extreme)
+var bosses = []Boss{
+	{
+		Name:      "Captain Redbeard",
+		Intro:     "The pirate captain blocks the way to Medium waters. Questions from every subject!",
+		Sprite:    "assets/enemy_battleship.png",
+		MaxHP:     150,
+		HitDamage: 15,
+		Questions: 24,
+		Phases: []BossPhase{
+			{Name: "Boarding Party", Threshold: 150, TimeLimit: 10 * time.Second},
+			{Name: "Cannon Barrage", Threshold: 100, DamageReduction: 0.1, TimeLimit: 9 * time.Second, Attack: "Cannon Barrage", AttackEvery: 4, AttackDrain: 1},
+			{Name: "Last Stand", Threshold: 50, DamageReduction: 0.2, TimeLimit: 8 * time.Second, Attack: "Fire Ship", AttackEvery: 3, AttackDrain: 1},
+		},
+	},
+	{
+		Name:      "The Kraken",
+		Intro:     "A sea monster rises from the deep. Its tentacles grow faster as it weakens!",
+		Sprite:    "assets/enemy_battleship.png",
+		MaxHP:     180,
+		HitDamage: 15,
+		Questions: 28,
+		Phases: []BossPhase{
+			{Name: "Circling", Threshold: 180, TimeLimit: 10 * time.Second},
+			{Name: "Tentacle Grip", Threshold: 120, DamageReduction: 0.15, TimeLimit: 8 * time.Second, Attack: "Tentacle Slam", AttackEvery: 4, AttackDrain: 1},
+			{Name: "Whirlpool", Threshold: 60, DamageReduction: 0.25, TimeLimit: 7 * time.Second, Attack: "Whirlpool", AttackEvery: 3, AttackDrain: 2},
+		},
+	},
+	{
+		Name:      "Admiral Ironhull",
+		Intro:     "The enemy flagship and its admiral. Armor thickens with every phase!",
+		Sprite:    "assets/enemy_battleship.png",
+		MaxHP:     200,
+		HitDamage: 15,
+		Questions: 32,
+		Phases: []BossPhase{
+			{Name: "Battle Line", Threshold: 200, DamageReduction: 0.1, TimeLimit: 9 * time.Second},
+			{Name: "Broadside", Threshold: 130, DamageReduction: 0.25, TimeLimit: 8 * time.Second, Attack: "Full Broadside", AttackEvery: 4, AttackDrain: 2},
+			{Name: "Ramming Speed", Threshold: 60, DamageReduction: 0.35, TimeLimit: 7 * time.Second, Attack: "Ram", AttackEvery: 3, AttackDrain: 2},
+		},
+	},
+	{
+		Name:      "The Leviathan",
+		Intro:     "The final guardian of the Extreme seas. Every phase is faster and tougher!",
+		Sprite:    "assets/enemy_battleship.png",
+		MaxHP:     240,
+		HitDamage: 15,
+		Questions: 36,
+		Phases: []BossPhase{
+			{Name: "Awakening", Threshold: 240, DamageReduction: 0.1, TimeLimit: 9 * time.Second},
+			{Name: "Tidal Wave", Threshold: 160, DamageReduction: 0.25, TimeLimit: 7 * time.Second, Attack: "Tidal Wave", AttackEvery: 4, AttackDrain: 2},
+			{Name: "Fury of the Deep", Threshold: 80, DamageReduction: 0.4, TimeLimit: 6 * time.Second, Attack: "Maelstrom", AttackEvery: 3, AttackDrain: 3},
+		},
+	},
+}
+
+// GetBoss returns the boss guarding the given level
+func GetBoss(level int) Boss {
+	if level < 0 || level >= len(bosses) {
+		return bosses[0]
+	}
+	return bosses[level]
+}
+
+// PhaseFor returns the index of the phase the boss is in at the given HP
+func (b Boss) PhaseFor(hp int) int {
+	phase := 0
+	for i, p := range b.Phases {
+		if hp <= p.Threshold {
+			phase = i
+		}
+	}
+	return phase
+}
+
+// ProcessBossAnswer applies an answer to a boss battle. Scoring, combos and hints
+// work like ProcessAnswer; the boss's phase adds damage reduction and may fire a
+// special attack every few turns regardless of the answer. turn counts answered
+// questions before this one.
+func ProcessBossAnswer(
+	boss Boss,
+	level int,
+	playerHP int,
+	playerShields int,
+	bossHP int,
+	score int,
+	streak int,
+	turn int,
+	isCorrect bool,
+	responseTime time.Duration,
+	timeLimit time.Duration,
+	hintUsed bool,
+) CombatState {
+	phase := boss.Phases[boss.PhaseFor(bossHP)]
+	cs := CombatState{
+		PlayerHP:      playerHP,
+		PlayerShields: playerShields,
+		EnemyHP:       bossHP,
+		Score:         score,
+		Streak:        streak,
+	}
+	if isCorrect {
+		cs.Streak++
+		mult := ComboMultiplier(cs.Streak)
+		dmg := int(float64(boss.HitDamage) * mult * (1.0 - phase.DamageReduction))
+		cs.EnemyHP -= dmg
+		if cs.EnemyHP < 0 {
+			cs.EnemyHP = 0
+		}
+		points := basePoints[level]
+		cs.SpeedBonus = SpeedBonusCurve.Bonus(level, responseTime, timeLimit)
+		cs.ComboBonus = int(float64(points)*mult) - points
+		if hintUsed {
+			cs.HintPenalty = HintCost(level)
+		}
+		cs.PointsEarned = points - cs.HintPenalty
+		cs.Score += cs.PointsEarned + cs.ComboBonus + cs.SpeedBonus
+		cs.MainQDone = 1
+	} else {
+		cs.Streak = 0
+		cs.EnemyShots = 1
+		cs.PlayerShields, cs.PlayerHP = fireShots(level, 1, 1, cs.PlayerShields, cs.PlayerHP)
+	}
+	// The phase's special attack lands every few turns while the boss is afloat
+	if phase.Attack != "" && phase.AttackEvery > 0 && (turn+1)%phase.AttackEvery == 0 && cs.EnemyHP > 0 {
+		cs.SpecialAttack = phase.Attack
+		cs.PlayerShields, cs.PlayerHP = fireShots(level, 1, phase.AttackDrain, cs.PlayerShields, cs.PlayerHP)
+	}
+	cs.Multiplier = ComboMultiplier(cs.Streak)
+	cs.Phase = boss.PhaseFor(cs.EnemyHP)
+	cs.CombatOver = cs.PlayerHP == 0 || cs.EnemyHP == 0
+	return cs
+}
@@ -0,0 +1,179 @@
+package game
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Campaign node types
+const (
+	NodeBattle = "battle"
+	NodePort   = "port"
+)
+
+// CampaignTowHP is the hull a sunk ship is towed back to port with
+const CampaignTowHP = 30
+
+// CampaignNode is a stop on the sea chart: a battle or a repair port
+type CampaignNode struct {
+	ID         string   `json:"id"`
+	Name       string   `json:"name"`
+	Type       string   `json:"type"`       // NodeBattle or NodePort
+	Difficulty string   `json:"difficulty"` // battles only
+	Subjects   []string `json:"subjects"`   // battles only: question mix
+	Repair     int      `json:"repair"`     // ports only: hull points restored
+	X          float64  `json:"x"`          // chart position, 0..1
+	Y          float64  `json:"y"`          // chart position, 0..1
+	Next       []string `json:"next"`       // sea lanes to the following nodes
+}
+
+// Campaign is a chart of linked battles loaded from a data file
+type Campaign struct {
+	ID    string         `json:"id"`
+	Name  string         `json:"name"`
+	Nodes []CampaignNode `json:"nodes"` // the first node is where the voyage starts
+}
+
+// LoadCampaigns reads every *.json campaign in dir, sorted by file name
+func LoadCampaigns(dir string) ([]Campaign, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+	var campaigns []Campaign
+	for _, p := range paths {
+		data, err := os.ReadFile(p)
+		if err != nil {
+			return nil, err
+		}
+		var c Campaign
+		if err := json.Unmarshal(data, &c); err != nil {
+			return nil, fmt.Errorf("%s: %w", p, err)
+		}
+		if err := c.validate(); err != nil {
+			return nil, fmt.Errorf("%s: %w", p, err)
+		}
+		campaigns = append(campaigns, c)
+	}
+	return campaigns, nil
+}
+
+func (c *Campaign) validate() error {
+	if c.ID == "" || len(c.Nodes) == 0 {
+		return fmt.Errorf("campaign needs an id and at least one node")
+	}
+	for _, n := range c.Nodes {
+		for _, next := range n.Next {
+			if c.Node(next) == nil {
+				return fmt.Errorf("node %q links to unknown node %q", n.ID, next)
+			}
+		}
+		if n.Type == NodeBattle && (len(n.Subjects) == 0 || n.Difficulty == "") {
+			return fmt.Errorf("battle node %q needs subjects and a difficulty", n.ID)
+		}
+	}
+	return nil
+}
+
+// Node returns the node with the given ID, or nil
+func (c *Campaign) Node(id string) *CampaignNode {
+	for i := range c.Nodes {
+		if c.Nodes[i].ID == id {
+			return &c.Nodes[i]
+		}
+	}
+	return nil
+}
+
+// CampaignRun is a player's progress through a campaign. Hull and shields
+// carry over from one node to the next.
+type CampaignRun struct {
+	CampaignID string
+	Current    string // node the ship last visited
+	HP         int
+	Shields    int
+	Completed  map[string]bool
+}
+
+// loadedCampaignRun rebuilds a saved run; done lists the completed nodes, comma separated
+func loadedCampaignRun(campaignID, current string, hp, shields int, done string) *CampaignRun {
+	r := &CampaignRun{CampaignID: campaignID, Current: current, HP: hp, Shields: shields, Completed: make(map[string]bool)}
+	for _, id := range strings.Split(done, ",") {
+		if id != "" {
+			r.Completed[id] = true
+		}
+	}
+	return r
+}
+
+// NewCampaignRun starts a fresh voyage at the campaign's first node
+func NewCampaignRun(c *Campaign) *CampaignRun {
+	init := InitCombatState("Easy")
+	start := c.Nodes[0].ID
+	return &CampaignRun{
+		CampaignID: c.ID,
+		Current:    start,
+		HP:         init.PlayerMaxHP,
+		Shields:    init.PlayerMaxShields,
+		Completed:  map[string]bool{start: true},
+	}
+}
+
+// CanSail reports whether a node is reachable: not yet done and linked from a completed node
+func (r *CampaignRun) CanSail(c *Campaign, id string) bool {
+	if r.Completed[id] {
+		return false
+	}
+	for _, n := range c.Nodes {
+		if !r.Completed[n.ID] {
+			continue
+		}
+		for _, next := range n.Next {
+			if next == id {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// VisitPort repairs the ship at a port and marks it visited
+func (r *CampaignRun) VisitPort(node *CampaignNode) {
+	init := InitCombatState("Easy")
+	r.HP += node.Repair
+	if r.HP > init.PlayerMaxHP {
+		r.HP = init.PlayerMaxHP
+	}
+	r.Shields = init.PlayerMaxShields
+	r.Current = node.ID
+	r.Completed[node.ID] = true
+}
+
+// FinishBattle records the outcome of a battle node. A sunk ship is towed
+// back with CampaignTowHP and must fight the node again.
+func (r *CampaignRun) FinishBattle(node *CampaignNode, won bool, hp, shields int) {
+	r.HP = hp
+	r.Shields = shields
+	if won {
+		r.Current = node.ID
+		r.Completed[node.ID] = true
+	}
+	if r.HP <= 0 {
+		r.HP = CampaignTowHP
+	}
+}
+
+// IsComplete reports whether every battle on the chart has been won
+func (r *CampaignRun) IsComplete(c *Campaign) bool {
+	for _, n := range c.Nodes {
+		if n.Type == NodeBattle && !r.Completed[n.ID] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,151 @@
+package game
+
+import (
+	"math/rand"
+	"strings"
+	"time"
+)
+
+// Seat is a player's place in a multiplayer match. People answer through the
+// keyboard, mouse or a gamepad; computer captains pick their own answers, so
+// they can fill the empty seats of any mode.
+type Seat interface {
+	SeatName() string
+	// Decide picks an answer to q and how long the seat takes to give it. ok is
+	// false for people, whose answers come from input instead.
+	Decide(q Question) (choice int, delay time.Duration, ok bool)
+}
+
+// Human is a seat taken by a person
+type Human struct {
+	Name string
+}
+
+func (h Human) SeatName() string { return h.Name }
+
+func (h Human) Decide(q Question) (int, time.Duration, bool) { return -1, 0, false }
+
+// IsComputer reports whether a computer captain holds the seat
+func IsComputer(s Seat) bool {
+	_, ok := s.(*AICaptain)
+	return ok
+}
+
+// Personality is the skill sheet of a computer captain
+type Personality struct {
+	Name string
+	// Accuracy is the chance of a right answer per difficulty
+	Accuracy map[string]float64
+	// Subjects shifts the accuracy of a subject up or down; 0 if missing
+	Subjects map[string]float64
+	// Answer times follow a normal curve around ResponseMean
+	ResponseMean   time.Duration
+	ResponseSpread time.Duration
+}
+
+// The quickest a computer captain ever answers
+const minCaptainResponse = 700 * time.Millisecond
+
+// Personalities are the computer captains offered in the setup screens
+var Personalities = []Personality{
+	{
+		Name:           "Rookie Rowan",
+		Accuracy:       map[string]float64{"Easy": 0.65, "Medium": 0.5, "Hard": 0.35, "Extreme": 0.25},
+		Subjects:       map[string]float64{"English": 0.05},
+		ResponseMean:   6 * time.Second,
+		ResponseSpread: 2 * time.Second,
+	},
+	{
+		Name:           "Steady Morgan",
+		Accuracy:       map[string]float64{"Easy": 0.85, "Medium": 0.72, "Hard": 0.6, "Extreme": 0.48},
+		Subjects:       map[string]float64{"Math": 0.1, "Filipino": -0.1},
+		ResponseMean:   4 * time.Second,
+		ResponseSpread: 1500 * time.Millisecond,
+	},
+	{
+		Name:           "Quick Quinn",
+		Accuracy:       map[string]float64{"Easy": 0.75, "Medium": 0.6, "Hard": 0.48, "Extreme": 0.38},
+		Subjects:       map[string]float64{"Science": 0.08},
+		ResponseMean:   2 * time.Second,
+		ResponseSpread: 700 * time.Millisecond,
+	},
+	{
+		Name:           "Admiral Vale",
+		Accuracy:       map[string]float64{"Easy": 0.96, "Medium": 0.9, "Hard": 0.82, "Extreme": 0.74},
+		Subjects:       map[string]float64{"Filipino": 0.05},
+		ResponseMean:   3 * time.Second,
+		ResponseSpread: time.Second,
+	},
+}
+
+// AICaptain is a computer-controlled seat playing one personality
+type AICaptain struct {
+	Personality
+	rng *rand.Rand
+}
+
+// NewAICaptain seats a captain; the same seed replays the same answers, which keeps
+// automated tests repeatable.
+func NewAICaptain(p Personality, seed int64) *AICaptain {
+	return &AICaptain{Personality: p, rng: rand.New(rand.NewSource(seed))}
+}
+
+func (c *AICaptain) SeatName() string { return c.Name }
+
+// AccuracyFor is the chance the captain answers a question of the subject and
+// difficulty right. Unknown difficulties count as medium.
+func (c *AICaptain) AccuracyFor(subject, difficulty string) float64 {
+	acc, ok := c.Accuracy[difficulty]
+	if !ok {
+		acc = c.Accuracy["Medium"]
+	}
+	for s, shift := range c.Subjects {
+		if strings.EqualFold(s, subject) {
+			acc += shift
+		}
+	}
+	switch {
+	case acc < 0.05:
+		return 0.05
+	case acc > 0.99:
+		return 0.99
+	}
+	return acc
+}
+
+// Decide rolls for a right answer, otherwise picks one of the wrong choices.
+// Misses come a little slower, as the captain hesitates.
+func (c *AICaptain) Decide(q Question) (int, time.Duration, bool) {
+	right := q.AnswerIndex()
+	choice := right
+	correct := c.rng.Float64() < c.AccuracyFor(q.Subject, q.Difficulty)
+	if !correct && len(q.Choices) > 1 {
+		wrong := c.rng.Intn(len(q.Choices) - 1)
+		if right >= 0 && wrong >= right {
+			wrong++
+		}
+		choice = wrong
+	}
+	delay := c.ResponseMean + time.Duration(c.rng.NormFloat64()*float64(c.ResponseSpread))
+	if !correct {
+		delay += delay / 4
+	}
+	if delay < minCaptainResponse {
+		delay = minCaptainResponse
+	}
+	return choice, delay, true
+}
+
+// PlayVersus plays out a match on its own from the seats' decisions, taking the
+// questions in turn, for automated tests and balancing. People's seats and answers
+// slower than timeLimit count as misses.
+func PlayVersus(m *VersusMatch, questions []Question, timeLimit time.Duration) {
+	for _, q := range questions {
+		if m.Over() {
+			return
+		}
+		choice, delay, ok := m.Players[m.Turn].Seat.Decide(q)
+		correct := ok && delay <= timeLimit && choice == q.AnswerIndex()
+		m.Answer(correct, delay, timeLimit, false)
+	}
+}
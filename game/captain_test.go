@@ -0,0 +1,138 @@
+package game
+
+import (
+	"sort"
+	"testing"
+	"time"
+)
+
+// personality returns the computer captain with a name
+func personality(t *testing.T, name string) Personality {
+	for _, p := range Personalities {
+		if p.Name == name {
+			return p
+		}
+	}
+	t.Fatalf("no personality %q", name)
+	return Personality{}
+}
+
+// versusQuestions is a fixed order of the bank's questions of a difficulty
+func versusQuestions(difficulty string, seed int64) []Question {
+	quiz := NewQuiz()
+	subjects := quiz.ListSubjects()
+	sort.Strings(subjects)
+	return quiz.SelectQuestionsSeeded(subjects, difficulty, -1, seed)
+}
+
+// playCaptains plays a match between two seeded computer captains
+func playCaptains(a, b Personality, difficulty string, seed int64) *VersusMatch {
+	m := NewVersusMatch([2]string{a.Name, b.Name}, [2]int64{}, InitCombatState(difficulty).Level)
+	m.Players[0].Seat = NewAICaptain(a, seed)
+	m.Players[1].Seat = NewAICaptain(b, seed+1)
+	PlayVersus(m, versusQuestions(difficulty, seed), QuestionTimeLimit)
+	return m
+}
+
+// sheets are the players of a match without their seats, to compare two matches
+func sheets(m *VersusMatch) [2]VersusPlayer {
+	players := m.Players
+	for i := range players {
+		players[i].Seat = nil
+	}
+	return players
+}
+
+func TestPlayVersus(t *testing.T) {
+	cases := []struct {
+		a, b       string
+		difficulty string
+	}{
+		{"Admiral Vale", "Rookie Rowan", "Easy"},
+		{"Steady Morgan", "Quick Quinn", "Medium"},
+		{"Quick Quinn", "Quick Quinn", "Hard"},
+		{"Rookie Rowan", "Admiral Vale", "Extreme"},
+	}
+	for _, c := range cases {
+		t.Run(c.a+" vs "+c.b+" "+c.difficulty, func(t *testing.T) {
+			a, b := personality(t, c.a), personality(t, c.b)
+			for seed := int64(1); seed <= 20; seed++ {
+				m := playCaptains(a, b, c.difficulty, seed)
+				if !m.Over() {
+					t.Fatalf("seed %d: match not over after the questions ran out: %+v", seed, m.Players)
+				}
+				p0, p1 := m.Players[0], m.Players[1]
+				if p0.HP > 0 && p1.HP > 0 && (p0.Answered != VersusRounds || p1.Answered != VersusRounds) {
+					t.Errorf("seed %d: both ships afloat after %d and %d answers", seed, p0.Answered, p1.Answered)
+				}
+				want := -1
+				switch {
+				case p0.HP > p1.HP, p0.HP == p1.HP && p0.Score > p1.Score:
+					want = 0
+				case p0.HP < p1.HP, p0.HP == p1.HP && p0.Score < p1.Score:
+					want = 1
+				}
+				if w := m.Winner(); w != want {
+					t.Errorf("seed %d: winner %d, want %d for HP %d-%d, score %d-%d", seed, w, want, p0.HP, p1.HP, p0.Score, p1.Score)
+				}
+				if first, again := sheets(m), sheets(playCaptains(a, b, c.difficulty, seed)); first != again {
+					t.Fatalf("seed %d: replay differs: %+v, then %+v", seed, first, again)
+				}
+			}
+		})
+	}
+}
+
+func TestStrongerCaptainWins(t *testing.T) {
+	vale, rowan := personality(t, "Admiral Vale"), personality(t, "Rookie Rowan")
+	wins := 0
+	const matches = 50
+	for seed := int64(1); seed <= matches; seed++ {
+		// Rowan answers first, which is the edge in a close match
+		if playCaptains(rowan, vale, "Medium", seed).Winner() == 1 {
+			wins++
+		}
+	}
+	if wins < matches*3/4 {
+		t.Errorf("Admiral Vale won %d of %d matches against Rookie Rowan", wins, matches)
+	}
+}
+
+// TestCaptainBands checks each personality answers as often and as fast as its sheet says
+func TestCaptainBands(t *testing.T) {
+	const draws = 4000
+	for _, p := range Personalities {
+		for _, difficulty := range Difficulties {
+			q := Question{Text: "Which side is starboard?", Choices: []string{"Left", "Right", "Aft"}, Answer: "Right", Subject: "Math", Difficulty: difficulty}
+			c := NewAICaptain(p, 42)
+			correct := 0
+			var rightTime, wrongTime time.Duration
+			for i := 0; i < draws; i++ {
+				choice, delay, ok := c.Decide(q)
+				if !ok || choice < 0 || choice >= len(q.Choices) {
+					t.Fatalf("%s: decided %d, %v", p.Name, choice, ok)
+				}
+				if delay < minCaptainResponse {
+					t.Fatalf("%s: answered in %v, quicker than %v", p.Name, delay, minCaptainResponse)
+				}
+				if choice == q.AnswerIndex() {
+					correct++
+					rightTime += delay
+				} else {
+					wrongTime += delay
+				}
+			}
+			acc, want := float64(correct)/draws, c.AccuracyFor(q.Subject, difficulty)
+			if acc < want-0.03 || acc > want+0.03 {
+				t.Errorf("%s on %s: accuracy %.3f, want %.3f", p.Name, difficulty, acc, want)
+			}
+			mean := rightTime / time.Duration(correct)
+			if mean < p.ResponseMean*9/10 || mean > p.ResponseMean*11/10 {
+				t.Errorf("%s on %s: right answers take %v on average, want about %v", p.Name, difficulty, mean, p.ResponseMean)
+			}
+			if miss := wrongTime / time.Duration(draws-correct); miss <= mean {
+				t.Errorf("%s on %s: misses take %v on average, no slower than right answers (%v)", p.Name, difficulty, miss, mean)
+			}
+		}
+	}
+}
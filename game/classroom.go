@@ -0,0 +1,91 @@
+package game
+
+import (
+	"math"
+	"time"
+)
+
+// Classroom sessions: every student's ship battles one shared flagship while the
+// teacher's machine projects the questions
+const (
+	ClassQuestions    = 10
+	ClassSinkAccuracy = 0.8 // class accuracy that sinks the flagship on the last question
+)
+
+// ClassFlagshipHP is the hull of the flagship for a class of the given size
+func ClassFlagshipHP(level, students int) int {
+	if students < 1 {
+		students = 1
+	}
+	return GetEnemyClass(level).MaxHP * students
+}
+
+// ClassHitDamage is the damage one correct answer does to the flagship
+func ClassHitDamage(level int) int {
+	return int(math.Ceil(float64(GetEnemyClass(level).MaxHP) / (ClassQuestions * ClassSinkAccuracy)))
+}
+
+// ClassStudent is one student's result in a class session
+type ClassStudent struct {
+	Name         string
+	HP           int
+	Shields      int
+	Score        int
+	Correct      int
+	Answered     int
+	ResponseTime time.Duration // total over answered questions
+	Connected    bool
+}
+
+// AnswerStudent applies a student's answer: a hit damages the flagship, a miss draws
+// its fire. Sunk ships still answer for the report but no longer score or fire.
+// It returns the damage dealt to the flagship.
+func AnswerStudent(s *ClassStudent, level int, isCorrect bool, responseTime, timeLimit time.Duration) int {
+	s.Answered++
+	s.ResponseTime += responseTime
+	if !isCorrect {
+		if s.HP > 0 {
+			s.Shields, s.HP = EnemyFire(level, 1, s.Shields, s.HP)
+		}
+		return 0
+	}
+	s.Correct++
+	if s.HP == 0 {
+		return 0
+	}
+	points, _ := CalculatePoints(level, 0, false, false)
+	s.Score += points + SpeedBonusCurve.Bonus(level, responseTime, timeLimit)
+	return ClassHitDamage(level)
+}
+
+// AvgResponse is the student's average time to answer
+func (s ClassStudent) AvgResponse() time.Duration {
+	if s.Answered == 0 {
+		return 0
+	}
+	return s.ResponseTime / time.Duration(s.Answered)
+}
+
+// ClassReport is the summary of a finished class session
+type ClassReport struct {
+	RoomCode     string
+	HostUserID   int64
+	Subject      string
+	Difficulty   string
+	Questions    int
+	FlagshipSunk bool
+	Students     []ClassStudent
+}
+
+// Accuracy is the class-wide share of correct answers, 0-100
+func (r ClassReport) Accuracy() int {
+	correct, answered := 0, 0
+	for _, s := range r.Students {
+		correct += s.Correct
+		answered += s.Answered
+	}
+	if answered == 0 {
+		return 0
+	}
+	return correct * 100 / answered
+}
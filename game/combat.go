@@ -2,6 +2,7 @@ package game
 
 import (
 	"fmt"
+	"time"
 )
 
 // Level constants
@@ -80,7 +81,7 @@ func CalculatePoints(level int, questionsLeft int, bonusActive bool, enemyDefeat
 
 // NewCombat sets up a new combat scenario
 func NewCombat(level int) (Player, Enemy) {
-	return Player{Shields: 3, Streak: 0, Weapon: Cannon, Score: 0}, Enemy{HP: 100, Level: level}
+	return Player{Shields: 3, Streak: 0, Weapon: Cannon, Score: 0}, Enemy{HP: GetEnemyClass(level).MaxHP, Level: level}
 }
 
 // CalculateDamage computes the damage for a correct answer
@@ -116,12 +117,14 @@ func ApplyAnswer(player *Player, enemy *Enemy, isCorrect, isBonus, isTimeout boo
 
 	if isCorrect {
 		player.Streak++
+		mult := ComboMultiplier(player.Streak)
 		// Calculate combat damage
-		dmg := CalculateDamage(enemy.Level, player.Weapon, isBonus)
+		dmg := int(float64(CalculateDamage(enemy.Level, player.Weapon, isBonus)) * mult)
 		enemy.HP -= dmg
 
 		// Calculate points
 		points, bonus := CalculatePoints(enemy.Level, questionsLeft, isBonus, enemy.HP <= 0)
+		points = int(float64(points) * mult)
 		player.Score += points + bonus
 
 		if bonus > 0 {
@@ -170,6 +173,7 @@ func AskQuestion() (isCorrect, isBonus, isTimeout bool) {
 func RunCombat(level int) {
 	player, enemy := NewCombat(level)
 	fmt.Printf("Starting battle: %s vs Enemy (HP: %d)\n", levelNames[level], enemy.HP)
+	fmt.Println(GetEnemyClass(level).Intro)
 
 	// Ask bonus question first
 	fmt.Println("Bonus Question!")
@@ -206,14 +210,27 @@ type CombatState struct {
 	CombatOver    bool
 	Rank          string
 	ScorePercent  int
+	PointsEarned  int // base points awarded for this answer
+	SpeedBonus    int // extra points for answering before the timer ran down
+	Streak        int // consecutive correct answers, 0 after a miss
+	Multiplier    float64
+	ComboBonus    int    // extra points from the combo multiplier
+	EnemyShots    int    // shots the enemy fired back
+	EnemyRepair   int    // hull points the enemy repaired
+	HintPenalty   int    // points deducted for revealing the hint
+	Phase         int    // boss battles: phase after this answer
+	SpecialAttack string // boss battles: special attack fired this turn, "" if none
 }
 
 // ProcessAnswer processes a quiz answer and returns the updated combat state.
+// enemyMaxHP is the hull the enemy started the battle with, which armor repairs
+// never exceed.
 func ProcessAnswer(
 	level int,
 	playerHP int,
 	playerShields int,
 	enemyHP int,
+	enemyMaxHP int,
 	score int,
 	mainQDone int,
 	bonusActive bool,
@@ -223,6 +240,11 @@ func ProcessAnswer(
 	isBonusQ bool,
 	isCorrect bool,
 	questionsLeft int,
+	responseTime time.Duration,
+	timeLimit time.Duration,
+	streak int,
+	isTimeout bool,
+	hintUsed bool,
 ) CombatState {
 	// Copy input state
 	newPlayerHP := playerHP
@@ -233,6 +255,14 @@ func ProcessAnswer(
 	newBonusActive := bonusActive
 	newBonusAnswered := bonusAnswered
 	combatOver := false
+	pointsEarned := 0
+	speedBonus := 0
+	newStreak := streak
+	comboBonus := 0
+	enemyShots := 0
+	enemyRepair := 0
+	hintPenalty := 0
+	class := GetEnemyClass(level)
 
 	// --- Handle Bonus Question ---
 	if isBonusQ && !bonusAnswered {
@@ -244,7 +274,7 @@ func ProcessAnswer(
 			// Bonus wrong: no bonus
 			newBonusAnswered = false
 		}
-		// No damage or points for bonus Q itself
+		// No damage or points for bonus Q itself, and the combo is left alone
 		return CombatState{
 			PlayerHP:      newPlayerHP,
 			PlayerShields: newPlayerShields,
@@ -254,13 +284,17 @@ func ProcessAnswer(
 			BonusActive:   newBonusActive,
 			BonusAnswered: newBonusAnswered,
 			CombatOver:    false,
+			Streak:        newStreak,
+			Multiplier:    ComboMultiplier(newStreak),
 		}
 	}
 
 	// --- Handle Main Questions ---
 	if isCorrect {
-		// Player deals damage to enemy
-		dmg := CalculateDamage(level, Cannon, newBonusActive)
+		newStreak++
+		mult := ComboMultiplier(newStreak)
+		// Player deals damage to enemy, scaled by the combo
+		dmg := int(float64(CalculateDamage(level, Cannon, newBonusActive)) * mult)
 		newEnemyHP -= dmg
 		if newEnemyHP < 0 {
 			newEnemyHP = 0
@@ -268,23 +302,35 @@ func ProcessAnswer(
 		newMainQDone++
 		// Award points for correct answer
 		points, _ := CalculatePoints(level, 0, false, false)
-		newScore += points
+		speedBonus = SpeedBonusCurve.Bonus(level, responseTime, timeLimit)
+		comboBonus = int(float64(points)*mult) - points
+		// A revealed hint costs part of this question's points
+		if hintUsed {
+			hintPenalty = HintCost(level)
+		}
+		pointsEarned = points - hintPenalty
+		newScore += pointsEarned + comboBonus + speedBonus
 		// If enemy defeated and bonus was correct, award bonus points for remaining questions
 		if newEnemyHP == 0 && newBonusActive && questionsLeft > 0 {
 			newScore += questionsLeft * basePoints[level]
 		}
 		// Bonus stays active for the entire round once activated
 	} else {
-		// Wrong answer: lose shield or take damage
-		if newPlayerShields > 0 {
-			newPlayerShields--
-		} else {
-			// Enemy deals fixed damage per level
-			enemyDmg := basePoints[level] // 10/20/30/40 per level
-			newPlayerHP -= enemyDmg
-			if newPlayerHP < 0 {
-				newPlayerHP = 0
+		// Wrong answer breaks the combo
+		newStreak = 0
+		// Enemy fires back: each shot drains shields first, then hits the hull
+		enemyShots = 1
+		if isTimeout {
+			enemyShots = class.TimeoutShots
+		}
+		newPlayerShields, newPlayerHP = EnemyFire(level, enemyShots, newPlayerShields, newPlayerHP)
+		// Some classes patch their armor while the player misses
+		if class.ArmorRegen > 0 && newEnemyHP > 0 {
+			enemyRepair = class.ArmorRegen
+			if newEnemyHP+enemyRepair > enemyMaxHP {
+				enemyRepair = enemyMaxHP - newEnemyHP
 			}
+			newEnemyHP += enemyRepair
 		}
 	}
 
@@ -301,6 +347,14 @@ func ProcessAnswer(
 		BonusActive:   newBonusActive,
 		BonusAnswered: newBonusAnswered,
 		CombatOver:    combatOver,
+		PointsEarned:  pointsEarned,
+		SpeedBonus:    speedBonus,
+		Streak:        newStreak,
+		Multiplier:    ComboMultiplier(newStreak),
+		ComboBonus:    comboBonus,
+		EnemyShots:    enemyShots,
+		EnemyRepair:   enemyRepair,
+		HintPenalty:   hintPenalty,
 	}
 }
 
@@ -0,0 +1,103 @@
+package game
+
+import (
+	"errors"
+	"hash/fnv"
+	"time"
+)
+
+// Daily challenge: one battle a day with the same questions, in the same order, for everyone
+const (
+	DailyQuestionCount = 15
+	dailyDateLayout    = "2006-01-02"
+)
+
+// ErrDailyPlayed is returned when a player already used today's attempt
+var ErrDailyPlayed = errors.New("daily challenge already played today")
+
+// DailyChallenge describes the battle of one day
+type DailyChallenge struct {
+	Date        string // YYYY-MM-DD in UTC
+	BankVersion uint32
+	Seed        int64
+	Difficulty  string
+}
+
+// DailyResult is one day of a player's daily challenge history
+type DailyResult struct {
+	Date          string
+	Score         int
+	LongestStreak int
+}
+
+// BankVersion fingerprints the question bank, so the daily set changes when the bank does
+func (q *Quiz) BankVersion() uint32 {
+	h := fnv.New32a()
+	for _, ques := range q.Questions {
+		h.Write([]byte(ques.Subject + "|" + ques.Difficulty + "|" + ques.Text + "|" + ques.Answer + "\n"))
+	}
+	return h.Sum32()
+}
+
+// DailySeed derives the shuffle seed of a day from its date and the bank version
+func DailySeed(date string, bankVersion uint32) int64 {
+	h := fnv.New64a()
+	h.Write([]byte(date))
+	h.Write([]byte{byte(bankVersion >> 24), byte(bankVersion >> 16), byte(bankVersion >> 8), byte(bankVersion)})
+	return int64(h.Sum64())
+}
+
+// NewDailyChallenge returns the challenge for the UTC day of now. The difficulty
+// rotates through the tiers day by day.
+func (q *Quiz) NewDailyChallenge(now time.Time) DailyChallenge {
+	day := now.UTC()
+	date := day.Format(dailyDateLayout)
+	version := q.BankVersion()
+	levels := []string{"Easy", "Medium", "Hard", "Extreme"}
+	return DailyChallenge{
+		Date:        date,
+		BankVersion: version,
+		Seed:        DailySeed(date, version),
+		Difficulty:  levels[(day.Unix()/86400)%int64(len(levels))],
+	}
+}
+
+// Questions returns the day's questions: every subject mixed, in seeded order.
+// The first one is the bonus question, as in a standard battle.
+func (c DailyChallenge) Questions(q *Quiz) []Question {
+	return q.SelectQuestionsSeeded(q.ListSubjects(), c.Difficulty, DailyQuestionCount+1, c.Seed)
+}
+
+// DailyStreaks returns the current and best run of consecutive days played.
+// dates are YYYY-MM-DD in ascending order; the current streak still counts if
+// the last day played was yesterday.
+func DailyStreaks(dates []string, today string) (current, best int) {
+	var prev time.Time
+	run := 0
+	for _, d := range dates {
+		day, err := time.Parse(dailyDateLayout, d)
+		if err != nil {
+			continue
+		}
+		switch {
+		case run > 0 && day.Equal(prev):
+			continue
+		case run > 0 && day.Sub(prev) == 24*time.Hour:
+			run++
+		default:
+			run = 1
+		}
+		prev = day
+		if run > best {
+			best = run
+		}
+	}
+	t, err := time.Parse(dailyDateLayout, today)
+	if err != nil || run == 0 {
+		return 0, best
+	}
+	if gap := t.Sub(prev); gap == 0 || gap == 24*time.Hour {
+		current = run
+	}
+	return current, best
+}
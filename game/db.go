@@ -1,22 +0,0 @@
-package game
-
-import (
-	"database/sql"
-	"log"
-
-	_ "github.com/go-sql-driver/mysql"
-)
-
-var DB *sql.DB
-
-// InitDB initializes the global DB connection
-func InitDB(dataSourceName string) {
-	var err error
-	DB, err = sql.Open("mysql", dataSourceName)
-	if err != nil {
-		log.Fatal("Failed to open DB:", err)
-	}
-	if err = DB.Ping(); err != nil {
-		log.Fatal("Failed to connect to DB:", err)
-	}
-} 
\ No newline at end of file
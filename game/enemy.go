@@ -0,0 +1,83 @@
+package game
+
+// EnemyClass describes an enemy ship type and its special abilities
+type EnemyClass struct {
+	Name         string
+	MaxHP        int
+	Sprite       string // sprite sheet path (4 frames, 64x64 each)
+	Intro        string // shown on the intro card when the battle starts
+	TimeoutShots int    // shots fired when the player runs out of time
+	ShieldDrain  int    // shields knocked out by each shot
+	ArmorRegen   int    // hull points repaired whenever the player misses
+}
+
+// enemyClasses holds one enemy class per level (see levelNames)
+var enemyClasses = []EnemyClass{
+	{
+		Name:         "Frigate",
+		MaxHP:        100,
+		Sprite:       "assets/enemy ship.png",
+		Intro:        "A light Frigate patrols these waters. No tricks, just steady fire.",
+		TimeoutShots: 1,
+		ShieldDrain:  1,
+	},
+	{
+		Name:         "Destroyer",
+		MaxHP:        100,
+		Sprite:       "assets/enemy_destroyer.png",
+		Intro:        "A fast Destroyer! If you run out of time it fires twice.",
+		TimeoutShots: 2,
+		ShieldDrain:  1,
+	},
+	{
+		Name:         "Cruiser",
+		MaxHP:        100,
+		Sprite:       "assets/enemy_cruiser.png",
+		Intro:        "An armored Cruiser. Its crew repairs the hull every time you miss.",
+		TimeoutShots: 1,
+		ShieldDrain:  1,
+		ArmorRegen:   5,
+	},
+	{
+		Name:         "Battleship",
+		MaxHP:        100,
+		Sprite:       "assets/enemy_battleship.png",
+		Intro:        "A Battleship! Each of its heavy shells knocks out two shields.",
+		TimeoutShots: 1,
+		ShieldDrain:  2,
+	},
+}
+
+// GetEnemyClass returns the enemy class fought at the given level.
+func GetEnemyClass(level int) EnemyClass {
+	if level < 0 || level >= len(enemyClasses) {
+		return enemyClasses[0]
+	}
+	return enemyClasses[level]
+}
+
+// EnemyFire applies the given number of enemy shots to the player.
+// Each shot drains the class's ShieldDrain shields; once shields are gone
+// the shot hits the hull for the level's base damage instead.
+func EnemyFire(level, shots, playerShields, playerHP int) (newShields, newHP int) {
+	return fireShots(level, shots, GetEnemyClass(level).ShieldDrain, playerShields, playerHP)
+}
+
+// fireShots applies shots that each drain the given number of shields, or hit the hull once shields are down
+func fireShots(level, shots, drain, playerShields, playerHP int) (newShields, newHP int) {
+	newShields, newHP = playerShields, playerHP
+	for i := 0; i < shots; i++ {
+		if newShields > 0 {
+			newShields -= drain
+			if newShields < 0 {
+				newShields = 0
+			}
+		} else {
+			newHP -= basePoints[level]
+			if newHP < 0 {
+				newHP = 0
+			}
+		}
+	}
+	return newShields, newHP
+}
@@ -0,0 +1,152 @@
+package game
+
+import "time"
+
+// Co-op fleet battles: two to four ships take turns against one large enemy. Hits add
+// up against the shared enemy hull; a miss only draws fire on the ship that missed.
+const (
+	FleetMinShips = 2
+	FleetMaxShips = 4
+)
+
+// FleetShip is one student's ship in the fleet
+type FleetShip struct {
+	Name     string
+	Seat     Seat
+	UserID   int64
+	Subject  string
+	HP       int
+	Shields  int
+	Score    int
+	Correct  int
+	Answered int
+	Streak   int
+	Damage   int // total damage dealt to the enemy
+}
+
+// Fleet is the team state of a co-op battle
+type Fleet struct {
+	Ships      []FleetShip
+	Level      int
+	EnemyHP    int
+	EnemyMaxHP int
+	Turn       int // ship answering next
+	LastShip   int // ship that answered last
+	Questions  int // questions per ship
+}
+
+// NewFleet sets up a fleet against an enemy with one regular hull per ship
+func NewFleet(names []string, userIDs []int64, subjects []string, difficulty string) *Fleet {
+	start := InitCombatState(difficulty)
+	f := &Fleet{Level: start.Level, Questions: GetMainQuestionsCount(start.Level)}
+	for i, name := range names {
+		f.Ships = append(f.Ships, FleetShip{Name: name, Seat: Human{Name: name}, UserID: userIDs[i], Subject: subjects[i], HP: start.PlayerHP, Shields: start.PlayerShields})
+	}
+	f.EnemyMaxHP = start.EnemyMaxHP * len(f.Ships)
+	f.EnemyHP = f.EnemyMaxHP
+	return f
+}
+
+// Answer applies the answer of the ship whose turn it is and passes the turn to the
+// next ship still afloat. The returned CombatState is seen from that ship.
+func (f *Fleet) Answer(isCorrect, isTimeout bool, responseTime, timeLimit time.Duration, hintUsed bool) CombatState {
+	s := &f.Ships[f.Turn]
+	s.Answered++
+	var cs CombatState
+	if isCorrect {
+		s.Streak++
+		s.Correct++
+		mult := ComboMultiplier(s.Streak)
+		dmg := int(float64(CalculateDamage(f.Level, Cannon, false)) * mult)
+		if dmg > f.EnemyHP {
+			dmg = f.EnemyHP
+		}
+		f.EnemyHP -= dmg
+		s.Damage += dmg
+		points, _ := CalculatePoints(f.Level, 0, false, false)
+		cs.SpeedBonus = SpeedBonusCurve.Bonus(f.Level, responseTime, timeLimit)
+		cs.ComboBonus = int(float64(points)*mult) - points
+		if hintUsed {
+			cs.HintPenalty = HintCost(f.Level)
+		}
+		cs.PointsEarned = points - cs.HintPenalty
+		s.Score += cs.PointsEarned + cs.ComboBonus + cs.SpeedBonus
+	} else {
+		s.Streak = 0
+		cs.EnemyShots = 1
+		if isTimeout {
+			cs.EnemyShots = GetEnemyClass(f.Level).TimeoutShots
+		}
+		s.Shields, s.HP = EnemyFire(f.Level, cs.EnemyShots, s.Shields, s.HP)
+	}
+	cs.PlayerHP = s.HP
+	cs.PlayerShields = s.Shields
+	cs.EnemyHP = f.EnemyHP
+	cs.Score = f.TeamScore()
+	cs.MainQDone = s.Correct
+	cs.Streak = s.Streak
+	cs.Multiplier = ComboMultiplier(s.Streak)
+	f.LastShip = f.Turn
+	f.nextTurn()
+	cs.CombatOver = f.Over()
+	return cs
+}
+
+// Sink takes a ship out of the battle, as when its captain leaves a LAN fleet;
+// the turn passes on if it was theirs
+func (f *Fleet) Sink(i int) {
+	f.Ships[i].HP = 0
+	if f.Turn == i {
+		f.nextTurn()
+	}
+}
+
+// nextTurn moves to the next ship that is afloat and still has questions
+func (f *Fleet) nextTurn() {
+	for i := 1; i <= len(f.Ships); i++ {
+		n := (f.Turn + i) % len(f.Ships)
+		if s := f.Ships[n]; s.HP > 0 && s.Answered < f.Questions {
+			f.Turn = n
+			return
+		}
+	}
+}
+
+// Over reports whether the enemy is sunk, the whole fleet is sunk, or every ship
+// afloat has answered all its questions
+func (f *Fleet) Over() bool {
+	if f.EnemyHP == 0 {
+		return true
+	}
+	for _, s := range f.Ships {
+		if s.HP > 0 && s.Answered < f.Questions {
+			return false
+		}
+	}
+	return true
+}
+
+// Victory reports whether the fleet sank the enemy
+func (f *Fleet) Victory() bool {
+	return f.EnemyHP == 0
+}
+
+// TeamScore is the sum of the ships' scores, with a bonus per ship afloat after a victory
+func (f *Fleet) TeamScore() int {
+	total := 0
+	for _, s := range f.Ships {
+		total += s.Score
+		if f.EnemyHP == 0 && s.HP > 0 {
+			total += basePoints[f.Level] * 5
+		}
+	}
+	return total
+}
+
+// FleetEntry is a team on the fleet leaderboard
+type FleetEntry struct {
+	Members    string // captains' names, comma separated
+	Difficulty string
+	TeamScore  int
+	EnemySunk  bool
+}
@@ -0,0 +1,68 @@
+package game
+
+import "time"
+
+// Every leaderboard row keeps the mode, subject and difficulty of its battle, so
+// the board can be narrowed to one subject, a recent week or month, or a class.
+
+// Window is the stretch of time a leaderboard covers
+type Window int
+
+// Leaderboard windows
+const (
+	WindowAllTime Window = iota
+	WindowWeek           // since Monday
+	WindowMonth          // since the 1st
+)
+
+// Since returns when the window began, the zero time for all time
+func (w Window) Since(now time.Time) time.Time {
+	y, m, d := now.Date()
+	switch w {
+	case WindowWeek:
+		// Monday is the first day of the school week
+		back := (int(now.Weekday()) + 6) % 7
+		return time.Date(y, m, d-back, 0, 0, 0, 0, now.Location())
+	case WindowMonth:
+		return time.Date(y, m, 1, 0, 0, 0, 0, now.Location())
+	}
+	return time.Time{}
+}
+
+// LeaderboardQuery narrows the leaderboard; zero fields match every row
+type LeaderboardQuery struct {
+	Subject    string
+	Difficulty string
+	Since      time.Time
+	// ClassHost keeps the teacher with this user ID and the students of their class
+	// sessions. Students join a class by name, so they are matched by name.
+	ClassHost int64
+	Offset    int // rows to skip, for the pages after the first
+	Limit     int
+}
+
+// since formats the start of the query's window the way the stores keep
+// created_at, in UTC (MySQL sessions run in UTC, see mysqlDSN); empty for all time
+func (q LeaderboardQuery) since() string {
+	if q.Since.IsZero() {
+		return ""
+	}
+	return q.Since.UTC().Format(time.DateTime)
+}
+
+// LeaderboardPage is a page of the leaderboard. Rows are ranked by score, and
+// rows with the same score by which was saved first.
+type LeaderboardPage struct {
+	Entries []LeaderboardEntry
+	Offset  int // the first entry is ranked Offset+1
+	Total   int // rows that match the query on every page
+}
+
+// PersonalBest is a captain's best score in one mode, subject and difficulty
+type PersonalBest struct {
+	Mode       Mode
+	Subject    string
+	Difficulty string
+	Score      int
+	Battles    int
+}
@@ -0,0 +1,721 @@
+package game
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// memStore keeps everything in memory for a session that should leave nothing
+// behind. Rows of players it does not know are left out of the boards, as the
+// SQL stores' joins on users do.
+type memStore struct {
+	mu         sync.Mutex
+	users      []memUser // by user id - 1
+	scores     []memScore
+	battles    []Battle // by battle id - 1
+	attempts   []Attempt
+	progress   map[memProgressKey]float64 // best stars
+	bosses     map[memUserKey]int         // best score per user and difficulty
+	campaigns  map[memUserKey]*CampaignRun
+	practice   []memPractice
+	daily      []*memDaily
+	survival   []memSurvival
+	timeAttack []memTimeAttack
+	versus     []memVersus
+	fleets     []memFleet
+	classes    []ClassReport
+	ratings    map[memUserKey]Rating
+}
+
+type memUser struct {
+	name    string
+	pinHash string
+}
+
+type memScore struct {
+	userID int64
+	entry  LeaderboardEntry
+	at     time.Time
+}
+
+type memProgressKey struct {
+	userID              int64
+	subject, difficulty string
+}
+
+// memUserKey is a user's row keyed by one more column
+type memUserKey struct {
+	userID int64
+	key    string
+}
+
+type memPractice struct {
+	userID  int64
+	session PracticeSession
+}
+
+type memDaily struct {
+	userID      int64
+	date        string
+	bankVersion uint32
+	result      DailyResult
+}
+
+type memSurvival struct {
+	userID   int64
+	entry    SurvivalEntry
+	subjects string
+}
+
+type memTimeAttack struct {
+	userID     int64
+	difficulty string
+	entry      TimeAttackEntry
+	wrong      int
+}
+
+type memVersus struct {
+	difficulty string
+	players    [2]VersusPlayer
+	winnerID   int64
+}
+
+type memFleet struct {
+	entry   FleetEntry
+	members []int64
+}
+
+// NewMemoryStore returns an empty in-memory store
+func NewMemoryStore() Store {
+	return &memStore{
+		progress:  make(map[memProgressKey]float64),
+		bosses:    make(map[memUserKey]int),
+		campaigns: make(map[memUserKey]*CampaignRun),
+		ratings:   make(map[memUserKey]Rating),
+	}
+}
+
+// name looks up a user the way the SQL stores join on users
+func (s *memStore) name(userID int64) (string, bool) {
+	if userID < 1 || userID > int64(len(s.users)) {
+		return "", false
+	}
+	return s.users[userID-1].name, true
+}
+
+// Migrate has nothing to do, as the memory store has no schema
+func (s *memStore) Migrate() (from, to int, err error) {
+	return 0, 0, nil
+}
+
+func (s *memStore) Close() error {
+	return nil
+}
+
+func (s *memStore) InsertUser(name string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.users = append(s.users, memUser{name: name})
+	return int64(len(s.users)), nil
+}
+
+func (s *memStore) FindUsers(name string) ([]Account, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var accounts []Account
+	for i, u := range s.users {
+		if !strings.EqualFold(u.name, strings.TrimSpace(name)) {
+			continue
+		}
+		a := Account{ID: int64(i + 1), Name: u.name, PINHash: u.pinHash}
+		for _, row := range s.scores {
+			if row.userID == a.ID {
+				a.Battles++
+				a.Score += row.entry.Score
+			}
+		}
+		accounts = append(accounts, a)
+	}
+	sort.SliceStable(accounts, func(i, j int) bool { return accounts[i].Battles > accounts[j].Battles })
+	return accounts, nil
+}
+
+func (s *memStore) SetUserPIN(userID int64, hash string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if userID >= 1 && userID <= int64(len(s.users)) {
+		s.users[userID-1].pinHash = hash
+	}
+	return nil
+}
+
+func (s *memStore) InsertLeaderboard(userID int64, e LeaderboardEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.scores = append(s.scores, memScore{userID: userID, entry: e, at: time.Now()})
+	return nil
+}
+
+// leaderboard returns every row a query matches in rank order
+func (s *memStore) leaderboard(q LeaderboardQuery) []LeaderboardEntry {
+	var class map[string]bool
+	if q.ClassHost > 0 {
+		class = make(map[string]bool)
+		for _, r := range s.classes {
+			if r.HostUserID == q.ClassHost {
+				for _, st := range r.Students {
+					class[strings.ToLower(st.Name)] = true
+				}
+			}
+		}
+	}
+	var entries []LeaderboardEntry
+	for _, row := range s.scores {
+		name, ok := s.name(row.userID)
+		if !ok {
+			continue
+		}
+		e := row.entry
+		if (q.Subject != "" && e.Subject != q.Subject) || (q.Difficulty != "" && e.Difficulty != q.Difficulty) || row.at.Before(q.Since) {
+			continue
+		}
+		if class != nil && row.userID != q.ClassHost && !class[strings.ToLower(name)] {
+			continue
+		}
+		e.UserID, e.PlayerName = row.userID, name
+		entries = append(entries, e)
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].Score > entries[j].Score })
+	return entries
+}
+
+func (s *memStore) GetLeaderboard(q LeaderboardQuery) (LeaderboardPage, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entries := s.leaderboard(q)
+	page := LeaderboardPage{Offset: q.Offset, Total: len(entries)}
+	if q.Offset < len(entries) {
+		page.Entries = limitRows(entries[q.Offset:], q.Limit)
+	}
+	return page, nil
+}
+
+func (s *memStore) GetLeaderboardRank(q LeaderboardQuery, userID int64) (int, LeaderboardEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, e := range s.leaderboard(q) {
+		if e.UserID == userID {
+			return i + 1, e, nil
+		}
+	}
+	return 0, LeaderboardEntry{}, nil
+}
+
+func (s *memStore) GetPersonalBests(userID int64) ([]PersonalBest, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	type key struct {
+		mode                Mode
+		subject, difficulty string
+	}
+	byKey := make(map[key]*PersonalBest)
+	var bests []*PersonalBest
+	for _, row := range s.scores {
+		if row.userID != userID {
+			continue
+		}
+		e := row.entry
+		k := key{e.Mode, e.Subject, e.Difficulty}
+		b := byKey[k]
+		if b == nil {
+			b = &PersonalBest{Mode: e.Mode, Subject: e.Subject, Difficulty: e.Difficulty, Score: e.Score}
+			byKey[k] = b
+			bests = append(bests, b)
+		}
+		if e.Score > b.Score {
+			b.Score = e.Score
+		}
+		b.Battles++
+	}
+	result := make([]PersonalBest, len(bests))
+	for i, b := range bests {
+		result[i] = *b
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if a.Mode != b.Mode {
+			return a.Mode < b.Mode
+		}
+		if a.Subject != b.Subject {
+			return a.Subject < b.Subject
+		}
+		return a.Difficulty < b.Difficulty
+	})
+	return result, nil
+}
+
+func (s *memStore) ClassHost(userID int64, name string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, r := range s.classes {
+		if r.HostUserID == userID {
+			return userID, nil
+		}
+	}
+	for i := len(s.classes) - 1; i >= 0; i-- {
+		r := s.classes[i]
+		if r.HostUserID == 0 {
+			continue
+		}
+		for _, st := range r.Students {
+			if strings.EqualFold(st.Name, strings.TrimSpace(name)) {
+				return r.HostUserID, nil
+			}
+		}
+	}
+	return 0, nil
+}
+
+func (s *memStore) GetTotalScore(userID int64) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	total := 0
+	for _, row := range s.scores {
+		if row.userID == userID {
+			total += row.entry.Score
+		}
+	}
+	return total, nil
+}
+
+func (s *memStore) InsertAttempts(b Battle, records []AnswerRecord) (int64, error) {
+	if len(records) == 0 {
+		return 0, nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.battles = append(s.battles, b)
+	battleID := int64(len(s.battles))
+	for _, r := range records {
+		r = b.fill(r)
+		r.PowerUps = append([]PowerUp(nil), r.PowerUps...)
+		r.ResponseTime = r.ResponseTime.Truncate(time.Millisecond)
+		s.attempts = append(s.attempts, Attempt{BattleID: battleID, UserID: b.UserID, AnswerRecord: r})
+	}
+	return battleID, nil
+}
+
+func (s *memStore) GetBattleAttempts(battleID int64) ([]Attempt, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var attempts []Attempt
+	for _, a := range s.attempts {
+		if a.BattleID == battleID {
+			attempts = append(attempts, a)
+		}
+	}
+	return attempts, nil
+}
+
+func (s *memStore) GetUserAttempts(userID int64, limit int) ([]Attempt, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var attempts []Attempt
+	for i := len(s.attempts) - 1; i >= 0; i-- {
+		if s.attempts[i].UserID == userID {
+			attempts = append(attempts, s.attempts[i])
+		}
+	}
+	return limitRows(attempts, limit), nil
+}
+
+func (s *memStore) GetQuestionStats(subject, difficulty string) ([]QuestionStats, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	type key struct{ subject, question string }
+	byQuestion := make(map[key]*QuestionStats)
+	totalMs := make(map[key]int64)
+	var stats []*QuestionStats
+	for _, a := range s.attempts {
+		if (subject != "" && a.Subject != subject) || (difficulty != "" && a.Difficulty != difficulty) {
+			continue
+		}
+		k := key{a.Subject, a.Question}
+		q := byQuestion[k]
+		if q == nil {
+			q = &QuestionStats{Subject: a.Subject, Question: a.Question}
+			byQuestion[k] = q
+			stats = append(stats, q)
+		}
+		if a.QuestionID > q.QuestionID {
+			q.QuestionID = a.QuestionID
+		}
+		q.Attempts++
+		q.Correct += boolInt(a.Correct)
+		q.TimedOut += boolInt(a.TimedOut)
+		q.HintsUsed += boolInt(a.HintUsed)
+		totalMs[k] += a.ResponseTime.Milliseconds()
+	}
+	result := make([]QuestionStats, len(stats))
+	for i, q := range stats {
+		q.AvgResponse = time.Duration(float64(totalMs[key{q.Subject, q.Question}])/float64(q.Attempts)) * time.Millisecond
+		result[i] = *q
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		if ra, rb := float64(a.Correct)/float64(a.Attempts), float64(b.Correct)/float64(b.Attempts); ra != rb {
+			return ra < rb
+		}
+		if a.Attempts != b.Attempts {
+			return a.Attempts > b.Attempts
+		}
+		return a.Question < b.Question
+	})
+	return result, nil
+}
+
+func (s *memStore) HasCompletedDifficulty(userID int64, subject, difficulty string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.progress[memProgressKey{userID, subject, difficulty}]
+	return ok, nil
+}
+
+func (s *memStore) MarkDifficultyCompleted(userID int64, subject, difficulty string, stars float64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := memProgressKey{userID, subject, difficulty}
+	if best, ok := s.progress[key]; !ok || stars > best {
+		s.progress[key] = stars
+	}
+	return nil
+}
+
+func (s *memStore) GetCompletions(userID int64) ([]Completion, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var completions []Completion
+	for key, stars := range s.progress {
+		if key.userID == userID {
+			completions = append(completions, Completion{Subject: key.subject, Difficulty: key.difficulty, Stars: stars})
+		}
+	}
+	return completions, nil
+}
+
+func (s *memStore) RecordBossVictory(userID int64, difficulty string, score int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := memUserKey{userID, difficulty}
+	if best, ok := s.bosses[key]; !ok || score > best {
+		s.bosses[key] = score
+	}
+	return nil
+}
+
+func (s *memStore) HasDefeatedBoss(userID int64, difficulty string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.bosses[memUserKey{userID, difficulty}]
+	return ok, nil
+}
+
+func (s *memStore) SaveCampaignProgress(userID int64, r *CampaignRun) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	saved := *r
+	saved.Completed = make(map[string]bool)
+	for id, ok := range r.Completed {
+		if ok {
+			saved.Completed[id] = true
+		}
+	}
+	s.campaigns[memUserKey{userID, r.CampaignID}] = &saved
+	return nil
+}
+
+func (s *memStore) LoadCampaignProgress(userID int64, campaignID string) (*CampaignRun, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	saved, ok := s.campaigns[memUserKey{userID, campaignID}]
+	if !ok {
+		return nil, nil
+	}
+	var done []string
+	for id := range saved.Completed {
+		done = append(done, id)
+	}
+	return loadedCampaignRun(campaignID, saved.Current, saved.HP, saved.Shields, strings.Join(done, ",")), nil
+}
+
+func (s *memStore) SavePracticeSession(userID int64, p *PracticeSession) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.practice = append(s.practice, memPractice{userID: userID, session: *p})
+	return nil
+}
+
+// dailyRow finds a user's attempt of a day, nil if there is none
+func (s *memStore) dailyRow(userID int64, date string) *memDaily {
+	for _, d := range s.daily {
+		if d.userID == userID && d.date == date {
+			return d
+		}
+	}
+	return nil
+}
+
+func (s *memStore) StartDailyAttempt(userID int64, c DailyChallenge) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.dailyRow(userID, c.Date) != nil {
+		return ErrDailyPlayed
+	}
+	s.daily = append(s.daily, &memDaily{userID: userID, date: c.Date, bankVersion: c.BankVersion, result: DailyResult{Date: c.Date}})
+	return nil
+}
+
+func (s *memStore) SaveDailyResult(userID int64, date string, score, longestStreak int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if d := s.dailyRow(userID, date); d != nil {
+		d.result.Score, d.result.LongestStreak = score, longestStreak
+	}
+	return nil
+}
+
+func (s *memStore) HasPlayedDaily(userID int64, date string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.dailyRow(userID, date) != nil, nil
+}
+
+func (s *memStore) GetDailyLeaderboard(date string, limit int) ([]LeaderboardEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var entries []LeaderboardEntry
+	for _, d := range s.daily {
+		if name, ok := s.name(d.userID); ok && d.date == date {
+			entries = append(entries, LeaderboardEntry{PlayerName: name, Score: d.result.Score, LongestStreak: d.result.LongestStreak})
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].Score > entries[j].Score })
+	return limitRows(entries, limit), nil
+}
+
+func (s *memStore) GetDailyHistory(userID int64) ([]DailyResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var history []DailyResult
+	for _, d := range s.daily {
+		if d.userID == userID {
+			history = append(history, d.result)
+		}
+	}
+	sort.SliceStable(history, func(i, j int) bool { return history[i].Date < history[j].Date })
+	return history, nil
+}
+
+func (s *memStore) InsertSurvivalRun(userID int64, score, waves int, subjects string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.survival = append(s.survival, memSurvival{userID: userID, entry: SurvivalEntry{Score: score, Waves: waves}, subjects: subjects})
+	return nil
+}
+
+func (s *memStore) GetTopSurvival(limit int) ([]SurvivalEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var entries []SurvivalEntry
+	for _, row := range s.survival {
+		if name, ok := s.name(row.userID); ok {
+			e := row.entry
+			e.PlayerName = name
+			entries = append(entries, e)
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].Score != entries[j].Score {
+			return entries[i].Score > entries[j].Score
+		}
+		return entries[i].Waves > entries[j].Waves
+	})
+	return limitRows(entries, limit), nil
+}
+
+func (s *memStore) InsertTimeAttack(userID int64, r *TimeAttackRun) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.timeAttack = append(s.timeAttack, memTimeAttack{
+		userID:     userID,
+		difficulty: r.Difficulty,
+		entry:      TimeAttackEntry{Score: r.Score(), Correct: r.Correct},
+		wrong:      r.Wrong,
+	})
+	return nil
+}
+
+func (s *memStore) GetTopTimeAttack(limit int) ([]TimeAttackEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var entries []TimeAttackEntry
+	for _, row := range s.timeAttack {
+		if name, ok := s.name(row.userID); ok {
+			e := row.entry
+			e.PlayerName = name
+			entries = append(entries, e)
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].Score != entries[j].Score {
+			return entries[i].Score > entries[j].Score
+		}
+		return entries[i].Correct > entries[j].Correct
+	})
+	return limitRows(entries, limit), nil
+}
+
+func (s *memStore) InsertVersusMatch(m *VersusMatch, difficulty string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	row := memVersus{difficulty: difficulty, players: m.Players}
+	if w := m.Winner(); w >= 0 {
+		row.winnerID = m.Players[w].UserID
+	}
+	s.versus = append(s.versus, row)
+	return nil
+}
+
+func (s *memStore) InsertFleetResult(f *Fleet, difficulty string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	row := memFleet{entry: FleetEntry{Difficulty: difficulty, TeamScore: f.TeamScore(), EnemySunk: f.Victory()}}
+	for _, sh := range f.Ships {
+		row.members = append(row.members, sh.UserID)
+	}
+	s.fleets = append(s.fleets, row)
+	return nil
+}
+
+func (s *memStore) GetTopFleets(limit int) ([]FleetEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var entries []FleetEntry
+	for _, row := range s.fleets {
+		var names []string
+		for _, id := range row.members {
+			if name, ok := s.name(id); ok {
+				names = append(names, name)
+			}
+		}
+		if len(names) == 0 {
+			continue
+		}
+		e := row.entry
+		e.Members = strings.TrimSpace(strings.Join(names, ", "))
+		entries = append(entries, e)
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].EnemySunk != entries[j].EnemySunk {
+			return entries[i].EnemySunk
+		}
+		return entries[i].TeamScore > entries[j].TeamScore
+	})
+	return limitRows(entries, limit), nil
+}
+
+func (s *memStore) SaveClassReport(r ClassReport) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r.Students = append([]ClassStudent(nil), r.Students...)
+	s.classes = append(s.classes, r)
+	return nil
+}
+
+func (s *memStore) GetRating(userID int64, subject string) (Rating, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if r, ok := s.ratings[memUserKey{userID, subject}]; ok {
+		return r, nil
+	}
+	return Rating{Subject: subject, Rating: RatingStart}, nil
+}
+
+func (s *memStore) SaveRating(userID int64, r Rating) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ratings[memUserKey{userID, r.Subject}] = r
+	return nil
+}
+
+func (s *memStore) GetRatings(userID int64) ([]Rating, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var ratings []Rating
+	for key, r := range s.ratings {
+		if key.userID == userID {
+			ratings = append(ratings, r)
+		}
+	}
+	sort.Slice(ratings, func(i, j int) bool {
+		if ratings[i].Rating != ratings[j].Rating {
+			return ratings[i].Rating > ratings[j].Rating
+		}
+		return ratings[i].Subject < ratings[j].Subject
+	})
+	return ratings, nil
+}
+
+func (s *memStore) GetTopRatings(limit int) ([]RatingEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	type ranked struct {
+		entry  RatingEntry
+		rating float64
+		userID int64
+	}
+	var rows []ranked
+	for key, r := range s.ratings {
+		name, ok := s.name(key.userID)
+		if !ok || r.Games < RatingMinGames {
+			continue
+		}
+		rows = append(rows, ranked{RatingEntry{PlayerName: name, Subject: r.Subject, Rating: roundRating(r.Rating), Games: r.Games}, r.Rating, key.userID})
+	}
+	// Ties go by user and subject, as in the SQL stores
+	sort.Slice(rows, func(i, j int) bool {
+		a, b := rows[i], rows[j]
+		if a.rating != b.rating {
+			return a.rating > b.rating
+		}
+		if a.userID != b.userID {
+			return a.userID < b.userID
+		}
+		return a.entry.Subject < b.entry.Subject
+	})
+	var entries []RatingEntry
+	for _, row := range limitRows(rows, limit) {
+		entries = append(entries, row.entry)
+	}
+	return entries, nil
+}
+
+func boolInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// limitRows keeps the first limit rows, as LIMIT does
+func limitRows[T any](rows []T, limit int) []T {
+	if limit >= 0 && len(rows) > limit {
+		return rows[:limit]
+	}
+	return rows
+}
@@ -0,0 +1,167 @@
+package game
+
+import (
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Schema migrations live in migrations/<dialect>/NNNN_name.sql, one directory per
+// SQL backend. A migration is never edited once released; changes go in a new file
+// with the next version, for every backend. Columns are added one per ALTER TABLE
+// and indexes with CREATE INDEX, so the runner can skip those already there.
+//
+//go:embed migrations
+var migrationFiles embed.FS
+
+// migration is one numbered schema change
+type migration struct {
+	version int
+	name    string
+	sql     string
+}
+
+// schema_version has a row for each migration applied to the database
+const schemaVersionTable = `CREATE TABLE IF NOT EXISTS schema_version (
+  version INTEGER NOT NULL PRIMARY KEY,
+  name VARCHAR(100) NOT NULL,
+  applied_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+)`
+
+// loadMigrations returns the migrations in dir, oldest first
+func loadMigrations(dir string) ([]migration, error) {
+	dir = path.Join("migrations", dir)
+	entries, err := fs.ReadDir(migrationFiles, dir)
+	if err != nil {
+		return nil, err
+	}
+	var ms []migration
+	for _, e := range entries {
+		file := e.Name()
+		if !strings.HasSuffix(file, ".sql") {
+			continue
+		}
+		num, name, _ := strings.Cut(strings.TrimSuffix(file, ".sql"), "_")
+		version, err := strconv.Atoi(num)
+		if err != nil || version < 1 {
+			return nil, fmt.Errorf("migration %s: name must start with its version", file)
+		}
+		data, err := migrationFiles.ReadFile(path.Join(dir, file))
+		if err != nil {
+			return nil, err
+		}
+		ms = append(ms, migration{version: version, name: name, sql: string(data)})
+	}
+	sort.Slice(ms, func(i, j int) bool { return ms[i].version < ms[j].version })
+	for i := 1; i < len(ms); i++ {
+		if ms[i].version == ms[i-1].version {
+			return nil, fmt.Errorf("two migrations have version %d", ms[i].version)
+		}
+	}
+	return ms, nil
+}
+
+// statements splits a migration into its statements, which end with a semicolon
+// at the end of a line. Comment lines are dropped.
+func statements(sql string) []string {
+	var stmts []string
+	var cur strings.Builder
+	for _, line := range strings.Split(sql, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "--") {
+			continue
+		}
+		cur.WriteString(line)
+		cur.WriteString("\n")
+		if strings.HasSuffix(trimmed, ";") {
+			stmts = append(stmts, strings.TrimSuffix(strings.TrimSpace(cur.String()), ";"))
+			cur.Reset()
+		}
+	}
+	if s := strings.TrimSpace(cur.String()); s != "" {
+		stmts = append(stmts, s)
+	}
+	return stmts
+}
+
+// Migrate applies the migrations the database has not had yet, each in its own
+// transaction, and returns the schema version before and after
+func (s *sqlStore) Migrate() (from, to int, err error) {
+	if _, err := s.db.Exec(schemaVersionTable); err != nil {
+		return 0, 0, err
+	}
+	if err := s.db.QueryRow("SELECT COALESCE(MAX(version), 0) FROM schema_version").Scan(&from); err != nil {
+		return 0, 0, err
+	}
+	ms, err := loadMigrations(s.d.migrations)
+	if err != nil {
+		return from, from, err
+	}
+	to = from
+	for _, m := range ms {
+		if m.version <= to {
+			continue
+		}
+		if err := s.apply(m); err != nil {
+			return from, to, fmt.Errorf("migration %d %s: %w", m.version, m.name, err)
+		}
+		to = m.version
+	}
+	return from, to, nil
+}
+
+// Statements that add one column or index, which a migration run before may
+// have added already
+var (
+	addColumnStmt   = regexp.MustCompile("(?is)^ALTER TABLE\\s+`?(\\w+)`?\\s+ADD COLUMN\\s+`?(\\w+)`?")
+	createIndexStmt = regexp.MustCompile("(?is)^CREATE INDEX\\s+`?(\\w+)`?\\s+ON\\s+`?(\\w+)`?")
+)
+
+// applied reports whether a statement adds a column or index the database
+// already has
+func (s *sqlStore) applied(tx *sql.Tx, stmt string) (bool, error) {
+	var query, table, name string
+	if m := addColumnStmt.FindStringSubmatch(stmt); m != nil {
+		query, table, name = s.d.hasColumn, m[1], m[2]
+	} else if m := createIndexStmt.FindStringSubmatch(stmt); m != nil {
+		query, table, name = s.d.hasIndex, m[2], m[1]
+	} else {
+		return false, nil
+	}
+	var n int
+	err := tx.QueryRow(query, table, name).Scan(&n)
+	return n > 0, err
+}
+
+// apply runs one migration and records it. MySQL commits schema changes as it
+// goes, so there a failed migration can be left half done; running it again
+// skips the columns and indexes it already added.
+func (s *sqlStore) apply(m migration) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	for _, stmt := range statements(m.sql) {
+		done, err := s.applied(tx, stmt)
+		if err != nil {
+			return err
+		}
+		if done {
+			continue
+		}
+		if _, err := tx.Exec(stmt); err != nil {
+			return err
+		}
+	}
+	if _, err := tx.Exec("INSERT INTO schema_version (version, name) VALUES (?, ?)", m.version, m.name); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,230 @@
+-- The schema as of the first release with migrations. Every statement can run
+-- again, so databases set up by hand from the old broadside.sql dump are picked
+-- up too: their tables are kept and given the columns added since the dump.
+
+CREATE TABLE IF NOT EXISTS `users` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `name` varchar(100) NOT NULL,
+  `created_at` timestamp NULL DEFAULT current_timestamp(),
+  PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+CREATE TABLE IF NOT EXISTS `leaderboard` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `user_id` int(11) DEFAULT NULL,
+  `score` int(11) DEFAULT NULL,
+  `quests_completed` int(11) DEFAULT NULL,
+  `weapon_boosts` int(11) DEFAULT NULL,
+  `accuracy` float DEFAULT NULL,
+  `bonus_success` float DEFAULT NULL,
+  `longest_streak` int(11) NOT NULL DEFAULT 0,
+  `powerups_used` int(11) NOT NULL DEFAULT 0,
+  `created_at` timestamp NULL DEFAULT current_timestamp(),
+  PRIMARY KEY (`id`),
+  KEY `user_id` (`user_id`),
+  CONSTRAINT `leaderboard_ibfk_1` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+CREATE TABLE IF NOT EXISTS `question_responses` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `user_id` int(11) NOT NULL,
+  `subject` varchar(64) NOT NULL,
+  `difficulty` varchar(16) NOT NULL,
+  `question` text NOT NULL,
+  `correct` tinyint(1) NOT NULL DEFAULT 0,
+  `timed_out` tinyint(1) NOT NULL DEFAULT 0,
+  `response_ms` int(11) NOT NULL,
+  `speed_bonus` int(11) NOT NULL DEFAULT 0,
+  `powerups` varchar(64) NOT NULL DEFAULT '',
+  `hint_used` tinyint(1) NOT NULL DEFAULT 0,
+  `created_at` timestamp NULL DEFAULT current_timestamp(),
+  PRIMARY KEY (`id`),
+  KEY `user_id` (`user_id`),
+  CONSTRAINT `question_responses_ibfk_1` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+CREATE TABLE IF NOT EXISTS `user_progress` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `user_id` int(11) NOT NULL,
+  `subject` varchar(64) NOT NULL,
+  `difficulty` varchar(16) NOT NULL,
+  `completed_at` datetime DEFAULT current_timestamp(),
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `unique_progress` (`user_id`,`subject`,`difficulty`),
+  CONSTRAINT `user_progress_ibfk_1` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+CREATE TABLE IF NOT EXISTS `campaign_progress` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `user_id` int(11) NOT NULL,
+  `campaign_id` varchar(64) NOT NULL,
+  `current_node` varchar(64) NOT NULL,
+  `hp` int(11) NOT NULL,
+  `shields` int(11) NOT NULL,
+  `completed` text NOT NULL,
+  `updated_at` timestamp NULL DEFAULT current_timestamp(),
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `unique_campaign` (`user_id`,`campaign_id`),
+  CONSTRAINT `campaign_progress_ibfk_1` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+CREATE TABLE IF NOT EXISTS `boss_victories` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `user_id` int(11) NOT NULL,
+  `difficulty` varchar(20) NOT NULL,
+  `score` int(11) NOT NULL DEFAULT 0,
+  `defeated_at` timestamp NULL DEFAULT current_timestamp(),
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `unique_boss` (`user_id`,`difficulty`),
+  CONSTRAINT `boss_victories_ibfk_1` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+CREATE TABLE IF NOT EXISTS `practice_sessions` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `user_id` int(11) NOT NULL,
+  `subject` varchar(50) NOT NULL,
+  `difficulty` varchar(20) NOT NULL,
+  `correct` int(11) NOT NULL DEFAULT 0,
+  `incorrect` int(11) NOT NULL DEFAULT 0,
+  `skipped` int(11) NOT NULL DEFAULT 0,
+  `practiced_at` timestamp NULL DEFAULT current_timestamp(),
+  PRIMARY KEY (`id`),
+  KEY `user_id` (`user_id`),
+  CONSTRAINT `practice_sessions_ibfk_1` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+CREATE TABLE IF NOT EXISTS `survival_leaderboard` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `user_id` int(11) NOT NULL,
+  `score` int(11) NOT NULL,
+  `waves` int(11) NOT NULL,
+  `subjects` varchar(255) NOT NULL,
+  `played_at` timestamp NULL DEFAULT current_timestamp(),
+  PRIMARY KEY (`id`),
+  KEY `user_id` (`user_id`),
+  CONSTRAINT `survival_leaderboard_ibfk_1` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+CREATE TABLE IF NOT EXISTS `timeattack_leaderboard` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `user_id` int(11) NOT NULL,
+  `difficulty` varchar(20) NOT NULL,
+  `score` int(11) NOT NULL,
+  `correct` int(11) NOT NULL,
+  `wrong` int(11) NOT NULL,
+  `played_at` timestamp NULL DEFAULT current_timestamp(),
+  PRIMARY KEY (`id`),
+  KEY `user_id` (`user_id`),
+  CONSTRAINT `timeattack_leaderboard_ibfk_1` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+CREATE TABLE IF NOT EXISTS `daily_challenge` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `user_id` int(11) NOT NULL,
+  `challenge_date` date NOT NULL,
+  `bank_version` int(10) UNSIGNED NOT NULL,
+  `score` int(11) NOT NULL DEFAULT 0,
+  `longest_streak` int(11) NOT NULL DEFAULT 0,
+  `played_at` timestamp NULL DEFAULT current_timestamp(),
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `unique_daily` (`user_id`,`challenge_date`),
+  KEY `challenge_date` (`challenge_date`),
+  CONSTRAINT `daily_challenge_ibfk_1` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+CREATE TABLE IF NOT EXISTS `versus_matches` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `player1_id` int(11) NOT NULL,
+  `player2_id` int(11) NOT NULL,
+  `difficulty` varchar(20) NOT NULL,
+  `player1_score` int(11) NOT NULL,
+  `player2_score` int(11) NOT NULL,
+  `player1_hp` int(11) NOT NULL,
+  `player2_hp` int(11) NOT NULL,
+  `winner_id` int(11) DEFAULT NULL,
+  `played_at` timestamp NULL DEFAULT current_timestamp(),
+  PRIMARY KEY (`id`),
+  KEY `player1_id` (`player1_id`),
+  KEY `player2_id` (`player2_id`),
+  CONSTRAINT `versus_matches_ibfk_1` FOREIGN KEY (`player1_id`) REFERENCES `users` (`id`),
+  CONSTRAINT `versus_matches_ibfk_2` FOREIGN KEY (`player2_id`) REFERENCES `users` (`id`),
+  CONSTRAINT `versus_matches_ibfk_3` FOREIGN KEY (`winner_id`) REFERENCES `users` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+CREATE TABLE IF NOT EXISTS `class_sessions` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `room_code` varchar(8) NOT NULL,
+  `host_id` int(11) DEFAULT NULL,
+  `subject` varchar(100) NOT NULL,
+  `difficulty` varchar(20) NOT NULL,
+  `questions` int(11) NOT NULL,
+  `students` int(11) NOT NULL,
+  `accuracy` int(11) NOT NULL,
+  `flagship_sunk` tinyint(1) NOT NULL DEFAULT 0,
+  `played_at` timestamp NULL DEFAULT current_timestamp(),
+  PRIMARY KEY (`id`),
+  KEY `host_id` (`host_id`),
+  CONSTRAINT `class_sessions_ibfk_1` FOREIGN KEY (`host_id`) REFERENCES `users` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+CREATE TABLE IF NOT EXISTS `class_session_students` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `session_id` int(11) NOT NULL,
+  `name` varchar(100) NOT NULL,
+  `score` int(11) NOT NULL,
+  `correct` int(11) NOT NULL,
+  `answered` int(11) NOT NULL,
+  `avg_response_ms` int(11) NOT NULL,
+  `hp` int(11) NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `session_id` (`session_id`),
+  CONSTRAINT `class_session_students_ibfk_1` FOREIGN KEY (`session_id`) REFERENCES `class_sessions` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+CREATE TABLE IF NOT EXISTS `fleet_results` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `difficulty` varchar(20) NOT NULL,
+  `ships` int(11) NOT NULL,
+  `team_score` int(11) NOT NULL,
+  `enemy_sunk` tinyint(1) NOT NULL DEFAULT 0,
+  `enemy_hp` int(11) NOT NULL,
+  `played_at` timestamp NULL DEFAULT current_timestamp(),
+  PRIMARY KEY (`id`),
+  KEY `team_score` (`team_score`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+CREATE TABLE IF NOT EXISTS `fleet_members` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `result_id` int(11) NOT NULL,
+  `user_id` int(11) NOT NULL,
+  `subject` varchar(100) NOT NULL,
+  `score` int(11) NOT NULL,
+  `correct` int(11) NOT NULL,
+  `answered` int(11) NOT NULL,
+  `damage` int(11) NOT NULL,
+  `hp` int(11) NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `result_id` (`result_id`),
+  KEY `user_id` (`user_id`),
+  CONSTRAINT `fleet_members_ibfk_1` FOREIGN KEY (`result_id`) REFERENCES `fleet_results` (`id`),
+  CONSTRAINT `fleet_members_ibfk_2` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+CREATE TABLE IF NOT EXISTS `ratings` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `user_id` int(11) NOT NULL,
+  `subject` varchar(100) NOT NULL,
+  `rating` double NOT NULL DEFAULT 1200,
+  `games` int(11) NOT NULL DEFAULT 0,
+  `updated_at` timestamp NOT NULL DEFAULT current_timestamp(),
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `unique_rating` (`user_id`,`subject`),
+  KEY `rating` (`rating`),
+  CONSTRAINT `ratings_ibfk_1` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+-- Columns added to the tables of the original dump
+ALTER TABLE `leaderboard` ADD COLUMN `longest_streak` int(11) NOT NULL DEFAULT 0 AFTER `bonus_success`;
+ALTER TABLE `leaderboard` ADD COLUMN `powerups_used` int(11) NOT NULL DEFAULT 0 AFTER `longest_streak`;
+ALTER TABLE `question_responses` ADD COLUMN `powerups` varchar(64) NOT NULL DEFAULT '' AFTER `speed_bonus`;
+ALTER TABLE `question_responses` ADD COLUMN `hint_used` tinyint(1) NOT NULL DEFAULT 0 AFTER `powerups`;
@@ -0,0 +1,4 @@
+-- Returning players pick their account by name and may protect it with a PIN
+
+ALTER TABLE `users` ADD COLUMN `pin_hash` varchar(100) NOT NULL DEFAULT '';
+CREATE INDEX `name` ON `users` (`name`);
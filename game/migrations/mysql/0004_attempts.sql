@@ -0,0 +1,44 @@
+-- Every answer goes in the attempt log with the battle it was given in. The
+-- answers logged in question_responses move over without a battle.
+
+CREATE TABLE IF NOT EXISTS `battles` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `user_id` int(11) NOT NULL,
+  `mode` varchar(20) NOT NULL,
+  `subject` varchar(100) NOT NULL,
+  `difficulty` varchar(20) NOT NULL,
+  `played_at` timestamp NULL DEFAULT current_timestamp(),
+  PRIMARY KEY (`id`),
+  KEY `user_id` (`user_id`),
+  CONSTRAINT `battles_ibfk_1` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+CREATE TABLE IF NOT EXISTS `attempts` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `battle_id` int(11) DEFAULT NULL,
+  `user_id` int(11) NOT NULL,
+  `question_id` varchar(16) NOT NULL DEFAULT '',
+  `subject` varchar(100) NOT NULL,
+  `difficulty` varchar(20) NOT NULL,
+  `question` text NOT NULL,
+  `chosen` varchar(255) NOT NULL DEFAULT '',
+  `correct` tinyint(1) NOT NULL DEFAULT 0,
+  `timed_out` tinyint(1) NOT NULL DEFAULT 0,
+  `response_ms` int(11) NOT NULL,
+  `speed_bonus` int(11) NOT NULL DEFAULT 0,
+  `hint_used` tinyint(1) NOT NULL DEFAULT 0,
+  `powerups` varchar(64) NOT NULL DEFAULT '',
+  `created_at` timestamp NULL DEFAULT current_timestamp(),
+  PRIMARY KEY (`id`),
+  KEY `battle_id` (`battle_id`),
+  KEY `user_id` (`user_id`),
+  KEY `question_id` (`question_id`),
+  CONSTRAINT `attempts_ibfk_1` FOREIGN KEY (`battle_id`) REFERENCES `battles` (`id`),
+  CONSTRAINT `attempts_ibfk_2` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+INSERT INTO `attempts` (`user_id`, `subject`, `difficulty`, `question`, `correct`, `timed_out`, `response_ms`, `speed_bonus`, `hint_used`, `powerups`, `created_at`)
+SELECT `user_id`, `subject`, `difficulty`, `question`, `correct`, `timed_out`, `response_ms`, `speed_bonus`, `hint_used`, `powerups`, `created_at`
+FROM `question_responses` ORDER BY `id`;
+
+DROP TABLE `question_responses`;
@@ -0,0 +1,8 @@
+-- Leaderboard rows keep the mode, subject and difficulty of their battle so the
+-- board can be narrowed to them. Rows saved before this have them empty.
+
+ALTER TABLE `leaderboard` ADD COLUMN `mode` varchar(20) NOT NULL DEFAULT '';
+ALTER TABLE `leaderboard` ADD COLUMN `subject` varchar(255) NOT NULL DEFAULT '';
+ALTER TABLE `leaderboard` ADD COLUMN `difficulty` varchar(20) NOT NULL DEFAULT '';
+CREATE INDEX `subject_difficulty` ON `leaderboard` (`subject`, `difficulty`);
+CREATE INDEX `created_at` ON `leaderboard` (`created_at`);
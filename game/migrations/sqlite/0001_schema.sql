@@ -0,0 +1,186 @@
+-- The schema as of the first release with migrations, the same tables as
+-- migrations/mysql in SQLite's types
+
+CREATE TABLE IF NOT EXISTS users (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  name TEXT NOT NULL,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS leaderboard (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  user_id INTEGER REFERENCES users (id),
+  score INTEGER,
+  quests_completed INTEGER,
+  weapon_boosts INTEGER,
+  accuracy REAL,
+  bonus_success REAL,
+  longest_streak INTEGER NOT NULL DEFAULT 0,
+  powerups_used INTEGER NOT NULL DEFAULT 0,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS leaderboard_user_id ON leaderboard (user_id);
+
+CREATE TABLE IF NOT EXISTS question_responses (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  user_id INTEGER NOT NULL REFERENCES users (id),
+  subject TEXT NOT NULL,
+  difficulty TEXT NOT NULL,
+  question TEXT NOT NULL,
+  correct INTEGER NOT NULL DEFAULT 0,
+  timed_out INTEGER NOT NULL DEFAULT 0,
+  response_ms INTEGER NOT NULL,
+  speed_bonus INTEGER NOT NULL DEFAULT 0,
+  powerups TEXT NOT NULL DEFAULT '',
+  hint_used INTEGER NOT NULL DEFAULT 0,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS question_responses_user_id ON question_responses (user_id);
+
+CREATE TABLE IF NOT EXISTS user_progress (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  user_id INTEGER NOT NULL REFERENCES users (id),
+  subject TEXT NOT NULL,
+  difficulty TEXT NOT NULL,
+  completed_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE (user_id, subject, difficulty)
+);
+
+CREATE TABLE IF NOT EXISTS campaign_progress (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  user_id INTEGER NOT NULL REFERENCES users (id),
+  campaign_id TEXT NOT NULL,
+  current_node TEXT NOT NULL,
+  hp INTEGER NOT NULL,
+  shields INTEGER NOT NULL,
+  completed TEXT NOT NULL,
+  updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE (user_id, campaign_id)
+);
+
+CREATE TABLE IF NOT EXISTS boss_victories (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  user_id INTEGER NOT NULL REFERENCES users (id),
+  difficulty TEXT NOT NULL,
+  score INTEGER NOT NULL DEFAULT 0,
+  defeated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE (user_id, difficulty)
+);
+
+CREATE TABLE IF NOT EXISTS practice_sessions (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  user_id INTEGER NOT NULL REFERENCES users (id),
+  subject TEXT NOT NULL,
+  difficulty TEXT NOT NULL,
+  correct INTEGER NOT NULL DEFAULT 0,
+  incorrect INTEGER NOT NULL DEFAULT 0,
+  skipped INTEGER NOT NULL DEFAULT 0,
+  practiced_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS practice_sessions_user_id ON practice_sessions (user_id);
+
+CREATE TABLE IF NOT EXISTS survival_leaderboard (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  user_id INTEGER NOT NULL REFERENCES users (id),
+  score INTEGER NOT NULL,
+  waves INTEGER NOT NULL,
+  subjects TEXT NOT NULL,
+  played_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS survival_leaderboard_user_id ON survival_leaderboard (user_id);
+
+CREATE TABLE IF NOT EXISTS timeattack_leaderboard (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  user_id INTEGER NOT NULL REFERENCES users (id),
+  difficulty TEXT NOT NULL,
+  score INTEGER NOT NULL,
+  correct INTEGER NOT NULL,
+  wrong INTEGER NOT NULL,
+  played_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS timeattack_leaderboard_user_id ON timeattack_leaderboard (user_id);
+
+CREATE TABLE IF NOT EXISTS daily_challenge (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  user_id INTEGER NOT NULL REFERENCES users (id),
+  challenge_date TEXT NOT NULL,
+  bank_version INTEGER NOT NULL,
+  score INTEGER NOT NULL DEFAULT 0,
+  longest_streak INTEGER NOT NULL DEFAULT 0,
+  played_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE (user_id, challenge_date)
+);
+CREATE INDEX IF NOT EXISTS daily_challenge_date ON daily_challenge (challenge_date);
+
+CREATE TABLE IF NOT EXISTS versus_matches (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  player1_id INTEGER NOT NULL REFERENCES users (id),
+  player2_id INTEGER NOT NULL REFERENCES users (id),
+  difficulty TEXT NOT NULL,
+  player1_score INTEGER NOT NULL,
+  player2_score INTEGER NOT NULL,
+  player1_hp INTEGER NOT NULL,
+  player2_hp INTEGER NOT NULL,
+  winner_id INTEGER REFERENCES users (id),
+  played_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS class_sessions (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  room_code TEXT NOT NULL,
+  host_id INTEGER REFERENCES users (id),
+  subject TEXT NOT NULL,
+  difficulty TEXT NOT NULL,
+  questions INTEGER NOT NULL,
+  students INTEGER NOT NULL,
+  accuracy INTEGER NOT NULL,
+  flagship_sunk INTEGER NOT NULL DEFAULT 0,
+  played_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS class_session_students (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  session_id INTEGER NOT NULL REFERENCES class_sessions (id),
+  name TEXT NOT NULL,
+  score INTEGER NOT NULL,
+  correct INTEGER NOT NULL,
+  answered INTEGER NOT NULL,
+  avg_response_ms INTEGER NOT NULL,
+  hp INTEGER NOT NULL
+);
+CREATE INDEX IF NOT EXISTS class_session_students_session_id ON class_session_students (session_id);
+
+CREATE TABLE IF NOT EXISTS fleet_results (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  difficulty TEXT NOT NULL,
+  ships INTEGER NOT NULL,
+  team_score INTEGER NOT NULL,
+  enemy_sunk INTEGER NOT NULL DEFAULT 0,
+  enemy_hp INTEGER NOT NULL,
+  played_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS fleet_results_team_score ON fleet_results (team_score);
+
+CREATE TABLE IF NOT EXISTS fleet_members (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  result_id INTEGER NOT NULL REFERENCES fleet_results (id),
+  user_id INTEGER NOT NULL REFERENCES users (id),
+  subject TEXT NOT NULL,
+  score INTEGER NOT NULL,
+  correct INTEGER NOT NULL,
+  answered INTEGER NOT NULL,
+  damage INTEGER NOT NULL,
+  hp INTEGER NOT NULL
+);
+CREATE INDEX IF NOT EXISTS fleet_members_result_id ON fleet_members (result_id);
+
+CREATE TABLE IF NOT EXISTS ratings (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  user_id INTEGER NOT NULL REFERENCES users (id),
+  subject TEXT NOT NULL,
+  rating REAL NOT NULL DEFAULT 1200,
+  games INTEGER NOT NULL DEFAULT 0,
+  updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE (user_id, subject)
+);
+CREATE INDEX IF NOT EXISTS ratings_rating ON ratings (rating);
@@ -0,0 +1,4 @@
+-- Returning players pick their account by name and may protect it with a PIN
+
+ALTER TABLE users ADD COLUMN pin_hash TEXT NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS users_name ON users (name);
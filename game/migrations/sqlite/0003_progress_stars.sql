@@ -0,0 +1,3 @@
+-- Completed subjects keep their best stars
+
+ALTER TABLE user_progress ADD COLUMN best_stars REAL NOT NULL DEFAULT 0;
@@ -0,0 +1,39 @@
+-- Every answer goes in the attempt log with the battle it was given in. The
+-- answers logged in question_responses move over without a battle.
+
+CREATE TABLE IF NOT EXISTS battles (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  user_id INTEGER NOT NULL REFERENCES users (id),
+  mode TEXT NOT NULL,
+  subject TEXT NOT NULL,
+  difficulty TEXT NOT NULL,
+  played_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS battles_user_id ON battles (user_id);
+
+CREATE TABLE IF NOT EXISTS attempts (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  battle_id INTEGER REFERENCES battles (id),
+  user_id INTEGER NOT NULL REFERENCES users (id),
+  question_id TEXT NOT NULL DEFAULT '',
+  subject TEXT NOT NULL,
+  difficulty TEXT NOT NULL,
+  question TEXT NOT NULL,
+  chosen TEXT NOT NULL DEFAULT '',
+  correct INTEGER NOT NULL DEFAULT 0,
+  timed_out INTEGER NOT NULL DEFAULT 0,
+  response_ms INTEGER NOT NULL,
+  speed_bonus INTEGER NOT NULL DEFAULT 0,
+  hint_used INTEGER NOT NULL DEFAULT 0,
+  powerups TEXT NOT NULL DEFAULT '',
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS attempts_battle_id ON attempts (battle_id);
+CREATE INDEX IF NOT EXISTS attempts_user_id ON attempts (user_id);
+CREATE INDEX IF NOT EXISTS attempts_question_id ON attempts (question_id);
+
+INSERT INTO attempts (user_id, subject, difficulty, question, correct, timed_out, response_ms, speed_bonus, hint_used, powerups, created_at)
+SELECT user_id, subject, difficulty, question, correct, timed_out, response_ms, speed_bonus, hint_used, powerups, created_at
+FROM question_responses ORDER BY id;
+
+DROP TABLE question_responses;
@@ -0,0 +1,9 @@
+-- Leaderboard rows keep the mode, subject and difficulty of their battle so the
+-- board can be narrowed to them. Rows saved before this have them empty.
+
+ALTER TABLE leaderboard ADD COLUMN mode TEXT NOT NULL DEFAULT '';
+ALTER TABLE leaderboard ADD COLUMN subject TEXT NOT NULL DEFAULT '';
+ALTER TABLE leaderboard ADD COLUMN difficulty TEXT NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS leaderboard_subject_difficulty ON leaderboard (subject, difficulty);
+CREATE INDEX IF NOT EXISTS leaderboard_created_at ON leaderboard (created_at);
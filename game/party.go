@@ -0,0 +1,104 @@
+package game
+
+import (
+	"sort"
+	"time"
+)
+
+// Buzzer party: up to four players see the same question and the first to buzz answers
+const (
+	PartyMaxPlayers = 4
+	PartyMinPlayers = 2
+	PartyQuestions  = 12
+	PartyBuzzWindow = 10 * time.Second // time for anyone to buzz in
+	PartyAnswerTime = 5 * time.Second  // time the buzzing player has to answer
+)
+
+// PartyPlayer is one contestant of a buzzer party
+type PartyPlayer struct {
+	Name    string
+	Seat    Seat
+	Score   int
+	Correct int
+	Wrong   int
+}
+
+// PartyGame keeps the scores of a buzzer party and who holds the buzzer
+type PartyGame struct {
+	Players   []PartyPlayer
+	Level     int
+	Buzzed    int    // player answering the current question, -1 if nobody
+	LockedOut []bool // players who already missed the current question
+}
+
+// NewPartyGame sets up a party for the given player names
+func NewPartyGame(names []string, level int) *PartyGame {
+	p := &PartyGame{Level: level, Buzzed: -1}
+	for _, name := range names {
+		p.Players = append(p.Players, PartyPlayer{Name: name, Seat: Human{Name: name}})
+	}
+	p.LockedOut = make([]bool, len(p.Players))
+	return p
+}
+
+// NextQuestion opens the buzzers for a new question
+func (p *PartyGame) NextQuestion() {
+	p.Buzzed = -1
+	for i := range p.LockedOut {
+		p.LockedOut[i] = false
+	}
+}
+
+// Buzz gives the buzzer to player if nobody holds it and they haven't missed this question
+func (p *PartyGame) Buzz(player int) bool {
+	if p.Buzzed >= 0 || player < 0 || player >= len(p.Players) || p.LockedOut[player] {
+		return false
+	}
+	p.Buzzed = player
+	return true
+}
+
+// Answer scores the buzzing player's answer; a miss costs half a question's points and
+// locks them out. open reports whether anyone else may still buzz.
+func (p *PartyGame) Answer(isCorrect bool) (points int, open bool) {
+	if p.Buzzed < 0 {
+		return 0, false
+	}
+	pl := &p.Players[p.Buzzed]
+	if isCorrect {
+		points = basePoints[p.Level]
+		pl.Correct++
+	} else {
+		points = -basePoints[p.Level] / 2
+		pl.Wrong++
+		p.LockedOut[p.Buzzed] = true
+	}
+	pl.Score += points
+	p.Buzzed = -1
+	if isCorrect {
+		return points, false
+	}
+	for _, out := range p.LockedOut {
+		if !out {
+			return points, true
+		}
+	}
+	return points, false
+}
+
+// Podium returns the player indices from first to last place: highest score first,
+// then most correct answers
+func (p *PartyGame) Podium() []int {
+	order := make([]int, len(p.Players))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		pa, pb := p.Players[order[a]], p.Players[order[b]]
+		if pa.Score != pb.Score {
+			return pa.Score > pb.Score
+		}
+		return pa.Correct > pb.Correct
+	})
+	return order
+}
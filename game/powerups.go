@@ -0,0 +1,140 @@
+package game
+
+import (
+	"math/rand"
+	"time"
+)
+
+// PowerUp identifies a consumable battle aid
+type PowerUp int
+
+// Power-up kinds
+const (
+	PowerFiftyFifty   PowerUp = iota // removes one wrong choice
+	PowerExtraTime                   // adds ExtraTimeBonus to the question timer
+	PowerRepair                      // restores one shield
+	PowerSecondChance                // forgives the next wrong answer and allows a retry
+	NumPowerUps
+)
+
+var powerUpNames = []string{"50/50", "Extra Time", "Repair", "Second Chance"}
+
+// ExtraTimeBonus is how much time the Extra Time power-up adds to the current question
+const ExtraTimeBonus = 5 * time.Second
+
+// StreakForPowerUp is how many correct answers in a row earn a free power-up charge
+const StreakForPowerUp = 5
+
+// Power-ups usable in a single battle for each level (Easy..Extreme)
+var powerUpLimits = []int{4, 3, 2, 1}
+
+// Price of buying a power-up with score, in multiples of the level's base points
+var powerUpCosts = []int{2, 1, 3, 2}
+
+// String returns the display name of the power-up
+func (p PowerUp) String() string {
+	if p < 0 || p >= NumPowerUps {
+		return "Unknown"
+	}
+	return powerUpNames[p]
+}
+
+// PowerUpCost returns the score needed to buy a power-up at the given level
+func PowerUpCost(p PowerUp, level int) int {
+	return powerUpCosts[p] * basePoints[level]
+}
+
+// PowerUpLimit returns how many power-ups may be used in one battle at the given level
+func PowerUpLimit(level int) int {
+	return powerUpLimits[level]
+}
+
+// PowerUps tracks owned charges across battles and what has been used in the current battle
+type PowerUps struct {
+	Charges [NumPowerUps]int // owned charges, carried between battles
+	Used    [NumPowerUps]int // used in the current battle
+}
+
+// NewPowerUps creates an inventory with one free charge of each power-up
+func NewPowerUps() *PowerUps {
+	p := &PowerUps{}
+	for i := range p.Charges {
+		p.Charges[i] = 1
+	}
+	return p
+}
+
+// StartBattle clears the per-battle usage
+func (p *PowerUps) StartBattle() {
+	p.Used = [NumPowerUps]int{}
+}
+
+// UsedCount returns how many power-ups were used in the current battle
+func (p *PowerUps) UsedCount() int {
+	total := 0
+	for _, n := range p.Used {
+		total += n
+	}
+	return total
+}
+
+// CanUse reports whether a power-up can be used now, either from a charge or by
+// spending score. Each kind is usable once per battle, within the level's limit.
+func (p *PowerUps) CanUse(kind PowerUp, level, score int) bool {
+	if p.Used[kind] > 0 || p.UsedCount() >= PowerUpLimit(level) {
+		return false
+	}
+	return p.Charges[kind] > 0 || score >= PowerUpCost(kind, level)
+}
+
+// Use consumes a power-up and returns the score spent on it (0 when a charge was used).
+func (p *PowerUps) Use(kind PowerUp, level, score int) (cost int, ok bool) {
+	if !p.CanUse(kind, level, score) {
+		return 0, false
+	}
+	p.Used[kind]++
+	if p.Charges[kind] > 0 {
+		p.Charges[kind]--
+		return 0, true
+	}
+	return PowerUpCost(kind, level), true
+}
+
+// EarnForStreak awards a charge every StreakForPowerUp correct answers in a row,
+// choosing the kind the player has the fewest of.
+func (p *PowerUps) EarnForStreak(streak int) (PowerUp, bool) {
+	if streak == 0 || streak%StreakForPowerUp != 0 {
+		return 0, false
+	}
+	kind := PowerFiftyFifty
+	for k := PowerUp(1); k < NumPowerUps; k++ {
+		if p.Charges[k] < p.Charges[kind] {
+			kind = k
+		}
+	}
+	p.Charges[kind]++
+	return kind, true
+}
+
+// FiftyFifty picks a wrong choice to remove, skipping already removed ones.
+// Returns -1 if there is no wrong choice left to remove.
+func FiftyFifty(numChoices, answer int, removed map[int]bool) int {
+	var wrong []int
+	for i := 0; i < numChoices; i++ {
+		if i != answer && !removed[i] {
+			wrong = append(wrong, i)
+		}
+	}
+	if len(wrong) == 0 {
+		return -1
+	}
+	return wrong[rand.Intn(len(wrong))]
+}
+
+// RepairShield restores one shield, up to maxShields
+func RepairShield(shields, maxShields int) int {
+	if shields < maxShields {
+		shields++
+	}
+	return shields
+}
@@ -0,0 +1,39 @@
+package game
+
+// PracticeSession tallies a practice run. Practice has no HP, shields or timer,
+// and its results are kept apart from the leaderboard and difficulty unlocks.
+type PracticeSession struct {
+	Subject    string
+	Difficulty string
+	Correct    int
+	Incorrect  int
+	Skipped    int
+}
+
+// Answer counts an answered question
+func (p *PracticeSession) Answer(correct bool) {
+	if correct {
+		p.Correct++
+	} else {
+		p.Incorrect++
+	}
+}
+
+// Skip counts a skipped question
+func (p *PracticeSession) Skip() {
+	p.Skipped++
+}
+
+// Total returns the number of questions seen, skipped ones included
+func (p *PracticeSession) Total() int {
+	return p.Correct + p.Incorrect + p.Skipped
+}
+
+// Accuracy returns the percentage of answered questions that were correct
+func (p *PracticeSession) Accuracy() int {
+	answered := p.Correct + p.Incorrect
+	if answered == 0 {
+		return 0
+	}
+	return p.Correct * 100 / answered
+}
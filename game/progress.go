@@ -0,0 +1,103 @@
+package game
+
+// Difficulties are the tiers of the standard game in unlock order
+var Difficulties = []string{"Easy", "Medium", "Hard", "Extreme"}
+
+// IsDifficulty reports whether d is one of the Difficulties, spelled the same
+func IsDifficulty(d string) bool {
+	for _, name := range Difficulties {
+		if name == d {
+			return true
+		}
+	}
+	return false
+}
+
+// StarsToComplete is the fewest stars that complete a subject
+const StarsToComplete = 1.0
+
+// Completion is a subject a captain finished at a difficulty
+type Completion struct {
+	Subject    string
+	Difficulty string
+	Stars      float64 // the best result so far
+}
+
+// Progress is a captain's way through the standard game: the subjects completed
+// at each difficulty with their best stars, and the tiers whose boss is beaten.
+// Each difficulty after Easy opens when the boss of the tier before it is beaten.
+type Progress struct {
+	stars   map[string]map[string]float64 // by difficulty, then subject
+	cleared map[string]bool
+}
+
+// NewProgress returns the progress of a captain who has not played yet
+func NewProgress() *Progress {
+	return &Progress{stars: make(map[string]map[string]float64), cleared: make(map[string]bool)}
+}
+
+// LoadProgress reads a captain's progress from the store
+func LoadProgress(s Store, userID int64) (*Progress, error) {
+	p := NewProgress()
+	completions, err := s.GetCompletions(userID)
+	if err != nil {
+		return p, err
+	}
+	for _, c := range completions {
+		p.Complete(c.Difficulty, c.Subject, c.Stars)
+	}
+	for _, d := range Difficulties {
+		beaten, err := s.HasDefeatedBoss(userID, d)
+		if err != nil {
+			return p, err
+		}
+		if beaten {
+			p.Clear(d)
+		}
+	}
+	return p, nil
+}
+
+// Complete records a finished subject, keeping the best stars
+func (p *Progress) Complete(difficulty, subject string, stars float64) {
+	if p.stars[difficulty] == nil {
+		p.stars[difficulty] = make(map[string]float64)
+	}
+	if stars > p.stars[difficulty][subject] {
+		p.stars[difficulty][subject] = stars
+	}
+}
+
+// BestStars returns the best stars of a subject, 0 if it is not completed
+func (p *Progress) BestStars(difficulty, subject string) float64 {
+	return p.stars[difficulty][subject]
+}
+
+// Subjects returns the subjects completed at a difficulty
+func (p *Progress) Subjects(difficulty string) map[string]bool {
+	done := make(map[string]bool)
+	for subject := range p.stars[difficulty] {
+		done[subject] = true
+	}
+	return done
+}
+
+// Clear records the boss of a difficulty as beaten
+func (p *Progress) Clear(difficulty string) {
+	p.cleared[difficulty] = true
+}
+
+// Cleared reports whether the boss of a difficulty is beaten
+func (p *Progress) Cleared(difficulty string) bool {
+	return p.cleared[difficulty]
+}
+
+// Unlocked reports whether a difficulty is open
+func (p *Progress) Unlocked(difficulty string) bool {
+	for i, d := range Difficulties {
+		if d == difficulty {
+			return i == 0 || p.cleared[Difficulties[i-1]]
+		}
+	}
+	return false
+}
@@ -13,6 +13,9 @@ type Question struct {
 	Answer     string // correct answer (case-insensitive)
 	Subject    string
 	Difficulty string // e.g., "Easy", "Medium", "Hard", "Expert"
+	Hint       string // optional author-written hint, revealed for a point cost
+	// Explanation is shown with the correct answer in practice mode
+	Explanation string
 }
 
 // Quiz holds all questions
@@ -22,318 +25,318 @@ type Quiz struct {
 
 var sampleQuestions = []Question{
 	// Math(easy)
-	{Text: "What is 2 + 3?", Choices: []string{"4", "5", "6"}, Answer: "5", Subject: "Math", Difficulty: "Easy"},
-	{Text: "Which number comes after 7?", Choices: []string{"6", "8", "9"}, Answer: "8", Subject: "Math", Difficulty: "Easy"},
-	{Text: "What is 5 - 2?", Choices: []string{"3", "2", "4"}, Answer: "3", Subject: "Math", Difficulty: "Easy"},
-	{Text: "How many sides does a triangle have?", Choices: []string{"3", "4", "5"}, Answer: "3", Subject: "Math", Difficulty: "Easy"},
-	{Text: "What is the number before 10?", Choices: []string{"9", "8", "11"}, Answer: "9", Subject: "Math", Difficulty: "Easy"},
-	{Text: "Which is more: 6 or 9?", Choices: []string{"6", "9", "They are equal"}, Answer: "9", Subject: "Math", Difficulty: "Easy"},
-	{Text: "What shape is a wheel?", Choices: []string{"Square", "Circle", "Triangle"}, Answer: "Circle", Subject: "Math", Difficulty: "Easy"},
-	{Text: "What is 1 + 1?", Choices: []string{"1", "2", "3"}, Answer: "2", Subject: "Math", Difficulty: "Easy"},
-	{Text: "How many legs do two dogs have?", Choices: []string{"4", "8", "6"}, Answer: "8", Subject: "Math", Difficulty: "Easy"},
-	{Text: "Which of these is the smallest number?", Choices: []string{"3", "1", "2"}, Answer: "1", Subject: "Math", Difficulty: "Easy"},
-	{Text: "What time is it if the clock shows 12 and 0 minutes?", Choices: []string{"12 o'clock", "1 o'clock", "11 o'clock"}, Answer: "12 o'clock", Subject: "Math", Difficulty: "Easy"},
+	{Text: "What is 2 + 3?", Choices: []string{"4", "5", "6"}, Answer: "5", Subject: "Math", Difficulty: "Easy", Explanation: "Start at 2 and count up 3 more: 3, 4, 5."},
+	{Text: "Which number comes after 7?", Choices: []string{"6", "8", "9"}, Answer: "8", Subject: "Math", Difficulty: "Easy", Explanation: "Counting up goes 6, 7, 8, so 8 comes right after 7."},
+	{Text: "What is 5 - 2?", Choices: []string{"3", "2", "4"}, Answer: "3", Subject: "Math", Difficulty: "Easy", Explanation: "Start with 5 and take away 2: 4, 3. That leaves 3."},
+	{Text: "How many sides does a triangle have?", Choices: []string{"3", "4", "5"}, Answer: "3", Subject: "Math", Difficulty: "Easy", Hint: "Think about how many wheels a tricycle has.", Explanation: "Tri means three: a triangle has three straight sides and three corners."},
+	{Text: "What is the number before 10?", Choices: []string{"9", "8", "11"}, Answer: "9", Subject: "Math", Difficulty: "Easy", Explanation: "Counting up goes 8, 9, 10, so 9 comes right before 10."},
+	{Text: "Which is more: 6 or 9?", Choices: []string{"6", "9", "They are equal"}, Answer: "9", Subject: "Math", Difficulty: "Easy", Explanation: "9 comes after 6 when you count, so 9 is more."},
+	{Text: "What shape is a wheel?", Choices: []string{"Square", "Circle", "Triangle"}, Answer: "Circle", Subject: "Math", Difficulty: "Easy", Explanation: "A wheel is round with no corners, so it can roll. That shape is a circle."},
+	{Text: "What is 1 + 1?", Choices: []string{"1", "2", "3"}, Answer: "2", Subject: "Math", Difficulty: "Easy", Explanation: "One and one more makes two."},
+	{Text: "How many legs do two dogs have?", Choices: []string{"4", "8", "6"}, Answer: "8", Subject: "Math", Difficulty: "Easy", Hint: "Each dog has 4 legs. Count them twice.", Explanation: "One dog has 4 legs, so two dogs have 4 + 4 = 8 legs."},
+	{Text: "Which of these is the smallest number?", Choices: []string{"3", "1", "2"}, Answer: "1", Subject: "Math", Difficulty: "Easy", Explanation: "When you count, 1 comes before 2 and 3, so it is the smallest."},
+	{Text: "What time is it if the clock shows 12 and 0 minutes?", Choices: []string{"12 o'clock", "1 o'clock", "11 o'clock"}, Answer: "12 o'clock", Subject: "Math", Difficulty: "Easy", Explanation: "The hour hand is on 12 and no minutes have passed, so it is 12 o'clock."},
 	// Math(medium)
-	{Text: "What is 6 + 7?", Choices: []string{"13", "12", "14"}, Answer: "13", Subject: "Math", Difficulty: "Medium"},
-	{Text: "What is 10 - 4?", Choices: []string{"5", "6", "7"}, Answer: "6", Subject: "Math", Difficulty: "Medium"},
-	{Text: "Which number is greater: 15 or 12?", Choices: []string{"12", "15", "They are equal"}, Answer: "15", Subject: "Math", Difficulty: "Medium"},
-	{Text: "What is the next number in the pattern: 2, 4, 6, ?", Choices: []string{"8", "7", "10"}, Answer: "8", Subject: "Math", Difficulty: "Medium"},
-	{Text: "Which shape has 4 equal sides?", Choices: []string{"Circle", "Triangle", "Square"}, Answer: "Square", Subject: "Math", Difficulty: "Medium"},
-	{Text: "What is 3 + 9?", Choices: []string{"11", "12", "13"}, Answer: "12", Subject: "Math", Difficulty: "Medium"},
-	{Text: "What is 14 - 5?", Choices: []string{"9", "10", "8"}, Answer: "9", Subject: "Math", Difficulty: "Medium"},
-	{Text: "How many tens are there in 30?", Choices: []string{"2", "3", "4"}, Answer: "3", Subject: "Math", Difficulty: "Medium"},
-	{Text: "Which is the smallest: 17, 13, or 15?", Choices: []string{"17", "13", "15"}, Answer: "13", Subject: "Math", Difficulty: "Medium"},
-	{Text: "How many sides does a rectangle have?", Choices: []string{"3", "4", "5"}, Answer: "4", Subject: "Math", Difficulty: "Medium"},
-	{Text: "What number comes next: 5, 10, 15, ?", Choices: []string{"20", "25", "30"}, Answer: "20", Subject: "Math", Difficulty: "Medium"},
-	{Text: "If you have 4 apples and get 3 more, how many apples do you have?", Choices: []string{"6", "7", "8"}, Answer: "7", Subject: "Math", Difficulty: "Medium"},
-	{Text: "What is 20 - 8?", Choices: []string{"12", "11", "13"}, Answer: "12", Subject: "Math", Difficulty: "Medium"},
-	{Text: "How many legs do 3 cats have?", Choices: []string{"8", "10", "12"}, Answer: "12", Subject: "Math", Difficulty: "Medium"},
-	{Text: "What is 11 + 4?", Choices: []string{"15", "14", "13"}, Answer: "15", Subject: "Math", Difficulty: "Medium"},
-	{Text: "Which is more: 7 tens or 60?", Choices: []string{"60", "70", "They are equal"}, Answer: "70", Subject: "Math", Difficulty: "Medium"},
+	{Text: "What is 6 + 7?", Choices: []string{"13", "12", "14"}, Answer: "13", Subject: "Math", Difficulty: "Medium", Explanation: "6 + 6 = 12, and one more makes 13."},
+	{Text: "What is 10 - 4?", Choices: []string{"5", "6", "7"}, Answer: "6", Subject: "Math", Difficulty: "Medium", Explanation: "Count back 4 from 10: 9, 8, 7, 6."},
+	{Text: "Which number is greater: 15 or 12?", Choices: []string{"12", "15", "They are equal"}, Answer: "15", Subject: "Math", Difficulty: "Medium", Explanation: "Both have one ten. 5 ones is more than 2 ones, so 15 is greater."},
+	{Text: "What is the next number in the pattern: 2, 4, 6, ?", Choices: []string{"8", "7", "10"}, Answer: "8", Subject: "Math", Difficulty: "Medium", Explanation: "The pattern adds 2 each time, and 6 + 2 = 8."},
+	{Text: "Which shape has 4 equal sides?", Choices: []string{"Circle", "Triangle", "Square"}, Answer: "Square", Subject: "Math", Difficulty: "Medium", Explanation: "A square has four sides that are all the same length."},
+	{Text: "What is 3 + 9?", Choices: []string{"11", "12", "13"}, Answer: "12", Subject: "Math", Difficulty: "Medium", Explanation: "9 + 1 = 10, and 2 more makes 12."},
+	{Text: "What is 14 - 5?", Choices: []string{"9", "10", "8"}, Answer: "9", Subject: "Math", Difficulty: "Medium", Explanation: "14 - 4 = 10, and taking away 1 more leaves 9."},
+	{Text: "How many tens are there in 30?", Choices: []string{"2", "3", "4"}, Answer: "3", Subject: "Math", Difficulty: "Medium", Explanation: "Counting by tens: 10, 20, 30. That is three tens."},
+	{Text: "Which is the smallest: 17, 13, or 15?", Choices: []string{"17", "13", "15"}, Answer: "13", Subject: "Math", Difficulty: "Medium", Explanation: "All three have one ten, and 3 ones is the fewest, so 13 is smallest."},
+	{Text: "How many sides does a rectangle have?", Choices: []string{"3", "4", "5"}, Answer: "4", Subject: "Math", Difficulty: "Medium", Explanation: "A rectangle has four straight sides, two long and two short."},
+	{Text: "What number comes next: 5, 10, 15, ?", Choices: []string{"20", "25", "30"}, Answer: "20", Subject: "Math", Difficulty: "Medium", Explanation: "The pattern counts by fives, and 15 + 5 = 20."},
+	{Text: "If you have 4 apples and get 3 more, how many apples do you have?", Choices: []string{"6", "7", "8"}, Answer: "7", Subject: "Math", Difficulty: "Medium", Explanation: "Getting more means adding: 4 + 3 = 7."},
+	{Text: "What is 20 - 8?", Choices: []string{"12", "11", "13"}, Answer: "12", Subject: "Math", Difficulty: "Medium", Explanation: "20 - 10 = 10, then add back the 2 extra you took: 12."},
+	{Text: "How many legs do 3 cats have?", Choices: []string{"8", "10", "12"}, Answer: "12", Subject: "Math", Difficulty: "Medium", Hint: "A cat has 4 legs. Add 4 + 4 + 4.", Explanation: "Each cat has 4 legs: 4 + 4 + 4 = 12."},
+	{Text: "What is 11 + 4?", Choices: []string{"15", "14", "13"}, Answer: "15", Subject: "Math", Difficulty: "Medium", Explanation: "Count up 4 from 11: 12, 13, 14, 15."},
+	{Text: "Which is more: 7 tens or 60?", Choices: []string{"60", "70", "They are equal"}, Answer: "70", Subject: "Math", Difficulty: "Medium", Explanation: "7 tens is 70, and 70 is more than 60."},
 	// Math(hard)
-	{Text: "What is 9 + 6?", Choices: []string{"14", "15", "16"}, Answer: "15", Subject: "Math", Difficulty: "Hard"},
-	{Text: "What number is missing? 2, 4, __, 8", Choices: []string{"5", "6", "7"}, Answer: "6", Subject: "Math", Difficulty: "Hard"},
-	{Text: "Which number is in the tens place in 47?", Choices: []string{"4", "7", "0"}, Answer: "4", Subject: "Math", Difficulty: "Hard"},
-	{Text: "Tom has 3 red balls and 4 blue balls. How many balls does he have in total?", Choices: []string{"6", "7", "8"}, Answer: "7", Subject: "Math", Difficulty: "Hard"},
-	{Text: "What is 10 - 7 + 2?", Choices: []string{"5", "4", "3"}, Answer: "5", Subject: "Math", Difficulty: "Hard"},
-	{Text: "What is the largest number? 21, 12, or 19?", Choices: []string{"12", "19", "21"}, Answer: "21", Subject: "Math", Difficulty: "Hard"},
-	{Text: "Which is an even number?", Choices: []string{"5", "7", "8"}, Answer: "8", Subject: "Math", Difficulty: "Hard"},
-	{Text: "What is 3 + 3 + 3?", Choices: []string{"9", "6", "8"}, Answer: "9", Subject: "Math", Difficulty: "Hard"},
-	{Text: "Which number is 1 more than 99?", Choices: []string{"100", "98", "101"}, Answer: "100", Subject: "Math", Difficulty: "Hard"},
-	{Text: "How many sides does a rectangle have?", Choices: []string{"3", "4", "5"}, Answer: "4", Subject: "Math", Difficulty: "Hard"},
-	{Text: "If you count by 5s starting from 5, what comes after 15?", Choices: []string{"20", "25", "10"}, Answer: "20", Subject: "Math", Difficulty: "Hard"},
-	{Text: "You have 2 boxes. One has 6 apples and the other has 4. How many apples in total?", Choices: []string{"10", "9", "11"}, Answer: "10", Subject: "Math", Difficulty: "Hard"},
-	{Text: "What is double of 7?", Choices: []string{"13", "14", "15"}, Answer: "14", Subject: "Math", Difficulty: "Hard"},
-	{Text: "What is half of 10?", Choices: []string{"4", "5", "6"}, Answer: "5", Subject: "Math", Difficulty: "Hard"},
-	{Text: "Which group has more: 3 birds or 5 birds?", Choices: []string{"3 birds", "5 birds", "They are equal"}, Answer: "5 birds", Subject: "Math", Difficulty: "Hard"},
-	{Text: "What is 12 - 4 - 3?", Choices: []string{"6", "5", "4"}, Answer: "5", Subject: "Math", Difficulty: "Hard"},
-	{Text: "What is the smallest two-digit number?", Choices: []string{"10", "11", "12"}, Answer: "10", Subject: "Math", Difficulty: "Hard"},
-	{Text: "Which shape has 4 equal sides?", Choices: []string{"Rectangle", "Square", "Triangle"}, Answer: "Square", Subject: "Math", Difficulty: "Hard"},
-	{Text: "You have 5 pencils and give away 2. How many do you have left?", Choices: []string{"2", "3", "4"}, Answer: "3", Subject: "Math", Difficulty: "Hard"},
-	{Text: "What is 8 + 2 - 5?", Choices: []string{"4", "5", "6"}, Answer: "5", Subject: "Math", Difficulty: "Hard"},
-	{Text: "Which number comes next? 11, 13, 15, __", Choices: []string{"17", "18", "16"}, Answer: "17", Subject: "Math", Difficulty: "Hard"},
+	{Text: "What is 9 + 6?", Choices: []string{"14", "15", "16"}, Answer: "15", Subject: "Math", Difficulty: "Hard", Explanation: "9 + 1 = 10, and 5 more makes 15."},
+	{Text: "What number is missing? 2, 4, __, 8", Choices: []string{"5", "6", "7"}, Answer: "6", Subject: "Math", Difficulty: "Hard", Explanation: "The numbers go up by 2, so after 4 comes 6."},
+	{Text: "Which number is in the tens place in 47?", Choices: []string{"4", "7", "0"}, Answer: "4", Subject: "Math", Difficulty: "Hard", Explanation: "In 47 the 4 means four tens (40) and the 7 means seven ones."},
+	{Text: "Tom has 3 red balls and 4 blue balls. How many balls does he have in total?", Choices: []string{"6", "7", "8"}, Answer: "7", Subject: "Math", Difficulty: "Hard", Explanation: "In total means add: 3 + 4 = 7."},
+	{Text: "What is 10 - 7 + 2?", Choices: []string{"5", "4", "3"}, Answer: "5", Subject: "Math", Difficulty: "Hard", Explanation: "Work left to right: 10 - 7 = 3, then 3 + 2 = 5."},
+	{Text: "What is the largest number? 21, 12, or 19?", Choices: []string{"12", "19", "21"}, Answer: "21", Subject: "Math", Difficulty: "Hard", Explanation: "21 has two tens and the others have one, so 21 is largest."},
+	{Text: "Which is an even number?", Choices: []string{"5", "7", "8"}, Answer: "8", Subject: "Math", Difficulty: "Hard", Explanation: "Even numbers split into two equal groups. 8 is 4 + 4, but 5 and 7 are odd."},
+	{Text: "What is 3 + 3 + 3?", Choices: []string{"9", "6", "8"}, Answer: "9", Subject: "Math", Difficulty: "Hard", Explanation: "3 + 3 = 6, and 6 + 3 = 9. That is also 3 times 3."},
+	{Text: "Which number is 1 more than 99?", Choices: []string{"100", "98", "101"}, Answer: "100", Subject: "Math", Difficulty: "Hard", Explanation: "99 is the last two-digit number, so one more is 100."},
+	{Text: "How many sides does a rectangle have?", Choices: []string{"3", "4", "5"}, Answer: "4", Subject: "Math", Difficulty: "Hard", Explanation: "A rectangle has four straight sides, two long and two short."},
+	{Text: "If you count by 5s starting from 5, what comes after 15?", Choices: []string{"20", "25", "10"}, Answer: "20", Subject: "Math", Difficulty: "Hard", Explanation: "Counting by fives goes 5, 10, 15, 20."},
+	{Text: "You have 2 boxes. One has 6 apples and the other has 4. How many apples in total?", Choices: []string{"10", "9", "11"}, Answer: "10", Subject: "Math", Difficulty: "Hard", Explanation: "Put both boxes together: 6 + 4 = 10."},
+	{Text: "What is double of 7?", Choices: []string{"13", "14", "15"}, Answer: "14", Subject: "Math", Difficulty: "Hard", Explanation: "Double means two of the same: 7 + 7 = 14."},
+	{Text: "What is half of 10?", Choices: []string{"4", "5", "6"}, Answer: "5", Subject: "Math", Difficulty: "Hard", Explanation: "Half splits 10 into two equal groups of 5, because 5 + 5 = 10."},
+	{Text: "Which group has more: 3 birds or 5 birds?", Choices: []string{"3 birds", "5 birds", "They are equal"}, Answer: "5 birds", Subject: "Math", Difficulty: "Hard", Explanation: "5 is more than 3, so the group of 5 birds has more."},
+	{Text: "What is 12 - 4 - 3?", Choices: []string{"6", "5", "4"}, Answer: "5", Subject: "Math", Difficulty: "Hard", Explanation: "Work left to right: 12 - 4 = 8, then 8 - 3 = 5."},
+	{Text: "What is the smallest two-digit number?", Choices: []string{"10", "11", "12"}, Answer: "10", Subject: "Math", Difficulty: "Hard", Explanation: "9 is the last one-digit number, so 10 is the first with two digits."},
+	{Text: "Which shape has 4 equal sides?", Choices: []string{"Rectangle", "Square", "Triangle"}, Answer: "Square", Subject: "Math", Difficulty: "Hard", Explanation: "A square has four equal sides. A rectangle's long and short sides differ."},
+	{Text: "You have 5 pencils and give away 2. How many do you have left?", Choices: []string{"2", "3", "4"}, Answer: "3", Subject: "Math", Difficulty: "Hard", Explanation: "Giving away means taking away: 5 - 2 = 3."},
+	{Text: "What is 8 + 2 - 5?", Choices: []string{"4", "5", "6"}, Answer: "5", Subject: "Math", Difficulty: "Hard", Explanation: "Work left to right: 8 + 2 = 10, then 10 - 5 = 5."},
+	{Text: "Which number comes next? 11, 13, 15, __", Choices: []string{"17", "18", "16"}, Answer: "17", Subject: "Math", Difficulty: "Hard", Explanation: "The numbers go up by 2, and 15 + 2 = 17."},
 	// Math(extreme)
-	{Text: "What is the value of 12^2 + 5^2?", Choices: []string{"169", "154", "149"}, Answer: "169", Subject: "Math", Difficulty: "Extreme"},
-	{Text: "What is the square root of 2025?", Choices: []string{"45", "40", "50"}, Answer: "45", Subject: "Math", Difficulty: "Extreme"},
-	{Text: "What is the result of (8 × 7) ÷ (2 + 2)?", Choices: []string{"14", "13", "15"}, Answer: "14", Subject: "Math", Difficulty: "Extreme"},
-	{Text: "If x + y = 10 and x - y = 4, what is x?", Choices: []string{"7", "6", "5"}, Answer: "7", Subject: "Math", Difficulty: "Extreme"},
-	{Text: "What is the factorial of 5?", Choices: []string{"120", "60", "24"}, Answer: "120", Subject: "Math", Difficulty: "Extreme"},
-	{Text: "Solve: (3^3 + 2^4) × 2", Choices: []string{"98", "100", "88"}, Answer: "98", Subject: "Math", Difficulty: "Extreme"},
-	{Text: "What is the derivative of 3x^2?", Choices: []string{"6x", "3x", "2x"}, Answer: "6x", Subject: "Math", Difficulty: "Extreme"},
-	{Text: "What is the area of a circle with radius 7?", Choices: []string{"154", "144", "132"}, Answer: "154", Subject: "Math", Difficulty: "Extreme"},
-	{Text: "What is 111 × 111?", Choices: []string{"12321", "11111", "12221"}, Answer: "12321", Subject: "Math", Difficulty: "Extreme"},
-	{Text: "Solve for x: 2x + 3 = 17", Choices: []string{"7", "6", "8"}, Answer: "7", Subject: "Math", Difficulty: "Extreme"},
-	{Text: "What is the sum of interior angles of a decagon?", Choices: []string{"1440", "1260", "1080"}, Answer: "1440", Subject: "Math", Difficulty: "Extreme"},
-	{Text: "What is log₁₀(1000)?", Choices: []string{"3", "2", "1"}, Answer: "3", Subject: "Math", Difficulty: "Extreme"},
-	{Text: "What is the integral of x dx?", Choices: []string{"x^2 / 2 + C", "x^2 + C", "2x + C"}, Answer: "x^2 / 2 + C", Subject: "Math", Difficulty: "Extreme"},
-	{Text: "What is the 10th Fibonacci number?", Choices: []string{"55", "34", "89"}, Answer: "55", Subject: "Math", Difficulty: "Extreme"},
-	{Text: "What is 2 to the power of 10?", Choices: []string{"1024", "1000", "512"}, Answer: "1024", Subject: "Math", Difficulty: "Extreme"},
-	{Text: "How many primes are there between 1 and 20?", Choices: []string{"8", "7", "9"}, Answer: "8", Subject: "Math", Difficulty: "Extreme"},
-	{Text: "What is the inverse of 5/2?", Choices: []string{"2/5", "1/5", "5/1"}, Answer: "2/5", Subject: "Math", Difficulty: "Extreme"},
-	{Text: "What is the solution of x^2 - 4x + 4 = 0?", Choices: []string{"x = 2", "x = 4", "x = -2"}, Answer: "x = 2", Subject: "Math", Difficulty: "Extreme"},
-	{Text: "Convert binary 1010 to decimal.", Choices: []string{"10", "12", "8"}, Answer: "10", Subject: "Math", Difficulty: "Extreme"},
-	{Text: "What is sin(90°)?", Choices: []string{"1", "0", "0.5"}, Answer: "1", Subject: "Math", Difficulty: "Extreme"},
-	{Text: "What is the cube root of 729?", Choices: []string{"9", "8", "7"}, Answer: "9", Subject: "Math", Difficulty: "Extreme"},
-	{Text: "Solve for x: x/3 = 7", Choices: []string{"21", "24", "18"}, Answer: "21", Subject: "Math", Difficulty: "Extreme"},
-	{Text: "If a = 2 and b = 3, what is ab^2?", Choices: []string{"18", "12", "16"}, Answer: "18", Subject: "Math", Difficulty: "Extreme"},
-	{Text: "What is the least common multiple of 6 and 8?", Choices: []string{"24", "48", "12"}, Answer: "24", Subject: "Math", Difficulty: "Extreme"},
-	{Text: "If a triangle has sides 3, 4, and 5, what type is it?", Choices: []string{"Right", "Acute", "Obtuse"}, Answer: "Right", Subject: "Math", Difficulty: "Extreme"},
-	{Text: "Evaluate: (4 + 5) × (6 - 2)", Choices: []string{"36", "32", "28"}, Answer: "36", Subject: "Math", Difficulty: "Extreme"},
+	{Text: "What is the value of 12^2 + 5^2?", Choices: []string{"169", "154", "149"}, Answer: "169", Subject: "Math", Difficulty: "Extreme", Explanation: "12 x 12 = 144 and 5 x 5 = 25, and 144 + 25 = 169."},
+	{Text: "What is the square root of 2025?", Choices: []string{"45", "40", "50"}, Answer: "45", Subject: "Math", Difficulty: "Extreme", Explanation: "45 x 45 = 2025, so the square root is 45."},
+	{Text: "What is the result of (8 × 7) ÷ (2 + 2)?", Choices: []string{"14", "13", "15"}, Answer: "14", Subject: "Math", Difficulty: "Extreme", Explanation: "Do the brackets first: 8 x 7 = 56 and 2 + 2 = 4, then 56 ÷ 4 = 14."},
+	{Text: "If x + y = 10 and x - y = 4, what is x?", Choices: []string{"7", "6", "5"}, Answer: "7", Subject: "Math", Difficulty: "Extreme", Explanation: "Add the two equations: 2x = 14, so x = 7 (and y = 3)."},
+	{Text: "What is the factorial of 5?", Choices: []string{"120", "60", "24"}, Answer: "120", Subject: "Math", Difficulty: "Extreme", Explanation: "5! = 5 x 4 x 3 x 2 x 1 = 120."},
+	{Text: "Solve: (3^3 + 2^4) × 2", Choices: []string{"98", "100", "88"}, Answer: "98", Subject: "Math", Difficulty: "Extreme", Explanation: "3^3 = 27 and 2^4 = 16, so (27 + 16) x 2 = 43 x 2 = 98."},
+	{Text: "What is the derivative of 3x^2?", Choices: []string{"6x", "3x", "2x"}, Answer: "6x", Subject: "Math", Difficulty: "Extreme", Explanation: "Bring the power down and lower it by one: 3 x 2 x^1 = 6x."},
+	{Text: "What is the area of a circle with radius 7?", Choices: []string{"154", "144", "132"}, Answer: "154", Subject: "Math", Difficulty: "Extreme", Explanation: "Area is pi x r x r. Using 22/7 for pi: 22/7 x 7 x 7 = 154."},
+	{Text: "What is 111 × 111?", Choices: []string{"12321", "11111", "12221"}, Answer: "12321", Subject: "Math", Difficulty: "Extreme", Explanation: "111 x 111 = 11100 + 1110 + 111 = 12321."},
+	{Text: "Solve for x: 2x + 3 = 17", Choices: []string{"7", "6", "8"}, Answer: "7", Subject: "Math", Difficulty: "Extreme", Explanation: "Take 3 from both sides to get 2x = 14, then halve it: x = 7."},
+	{Text: "What is the sum of interior angles of a decagon?", Choices: []string{"1440", "1260", "1080"}, Answer: "1440", Subject: "Math", Difficulty: "Extreme", Explanation: "A polygon with n sides has (n - 2) x 180 degrees. A decagon has 10 sides: 8 x 180 = 1440."},
+	{Text: "What is log₁₀(1000)?", Choices: []string{"3", "2", "1"}, Answer: "3", Subject: "Math", Difficulty: "Extreme", Explanation: "1000 = 10 x 10 x 10 = 10^3, so log base 10 of 1000 is 3."},
+	{Text: "What is the integral of x dx?", Choices: []string{"x^2 / 2 + C", "x^2 + C", "2x + C"}, Answer: "x^2 / 2 + C", Subject: "Math", Difficulty: "Extreme", Explanation: "Raise the power by one and divide by the new power: x^2 / 2, plus a constant C."},
+	{Text: "What is the 10th Fibonacci number?", Choices: []string{"55", "34", "89"}, Answer: "55", Subject: "Math", Difficulty: "Extreme", Explanation: "Each number is the sum of the two before it: 1, 1, 2, 3, 5, 8, 13, 21, 34, 55."},
+	{Text: "What is 2 to the power of 10?", Choices: []string{"1024", "1000", "512"}, Answer: "1024", Subject: "Math", Difficulty: "Extreme", Explanation: "Doubling 1 ten times gives 2, 4, 8, 16, 32, 64, 128, 256, 512, 1024."},
+	{Text: "How many primes are there between 1 and 20?", Choices: []string{"8", "7", "9"}, Answer: "8", Subject: "Math", Difficulty: "Extreme", Explanation: "The primes are 2, 3, 5, 7, 11, 13, 17 and 19. That is eight."},
+	{Text: "What is the inverse of 5/2?", Choices: []string{"2/5", "1/5", "5/1"}, Answer: "2/5", Subject: "Math", Difficulty: "Extreme", Explanation: "The inverse of a fraction flips it over, so 5/2 becomes 2/5. Their product is 1."},
+	{Text: "What is the solution of x^2 - 4x + 4 = 0?", Choices: []string{"x = 2", "x = 4", "x = -2"}, Answer: "x = 2", Subject: "Math", Difficulty: "Extreme", Explanation: "The left side is (x - 2)^2, which is zero only when x = 2."},
+	{Text: "Convert binary 1010 to decimal.", Choices: []string{"10", "12", "8"}, Answer: "10", Subject: "Math", Difficulty: "Extreme", Explanation: "Reading the places 8, 4, 2, 1: 8 + 0 + 2 + 0 = 10."},
+	{Text: "What is sin(90°)?", Choices: []string{"1", "0", "0.5"}, Answer: "1", Subject: "Math", Difficulty: "Extreme", Explanation: "At 90 degrees the point on the unit circle is straight up at (0, 1), so the sine is 1."},
+	{Text: "What is the cube root of 729?", Choices: []string{"9", "8", "7"}, Answer: "9", Subject: "Math", Difficulty: "Extreme", Explanation: "9 x 9 x 9 = 729, so the cube root is 9."},
+	{Text: "Solve for x: x/3 = 7", Choices: []string{"21", "24", "18"}, Answer: "21", Subject: "Math", Difficulty: "Extreme", Explanation: "Multiply both sides by 3: x = 21."},
+	{Text: "If a = 2 and b = 3, what is ab^2?", Choices: []string{"18", "12", "16"}, Answer: "18", Subject: "Math", Difficulty: "Extreme", Explanation: "Only b is squared: 2 x 3^2 = 2 x 9 = 18."},
+	{Text: "What is the least common multiple of 6 and 8?", Choices: []string{"24", "48", "12"}, Answer: "24", Subject: "Math", Difficulty: "Extreme", Explanation: "Multiples of 8 are 8, 16, 24. The first one 6 also divides is 24."},
+	{Text: "If a triangle has sides 3, 4, and 5, what type is it?", Choices: []string{"Right", "Acute", "Obtuse"}, Answer: "Right", Subject: "Math", Difficulty: "Extreme", Hint: "Check whether 3x3 + 4x4 equals 5x5.", Explanation: "9 + 16 = 25, so the sides fit the Pythagorean rule and the triangle has a right angle."},
+	{Text: "Evaluate: (4 + 5) × (6 - 2)", Choices: []string{"36", "32", "28"}, Answer: "36", Subject: "Math", Difficulty: "Extreme", Explanation: "Do the brackets first: 9 x 4 = 36."},
 	// English(easy)
-	{Text: "What is the opposite of 'big'?", Choices: []string{"Small", "Tall", "Long"}, Answer: "Small", Subject: "English", Difficulty: "Easy"},
-	{Text: "Which word is a noun?", Choices: []string{"Run", "Cat", "Blue"}, Answer: "Cat", Subject: "English", Difficulty: "Easy"},
-	{Text: "Which one is a vowel?", Choices: []string{"B", "E", "T"}, Answer: "E", Subject: "English", Difficulty: "Easy"},
-	{Text: "What sound does the letter 'B' make?", Choices: []string{"Buh", "Kuh", "Duh"}, Answer: "Buh", Subject: "English", Difficulty: "Easy"},
-	{Text: "Which word rhymes with 'cat'?", Choices: []string{"Dog", "Hat", "Pig"}, Answer: "Hat", Subject: "English", Difficulty: "Easy"},
-	{Text: "What is the correct article: '___ apple'?", Choices: []string{"A", "An", "The"}, Answer: "An", Subject: "English", Difficulty: "Easy"},
-	{Text: "What comes first in the alphabet?", Choices: []string{"A", "C", "B"}, Answer: "A", Subject: "English", Difficulty: "Easy"},
-	{Text: "Which is a color word?", Choices: []string{"Red", "Run", "Rat"}, Answer: "Red", Subject: "English", Difficulty: "Easy"},
-	{Text: "What is the plural of 'dog'?", Choices: []string{"Dog", "Dogs", "Doges"}, Answer: "Dogs", Subject: "English", Difficulty: "Easy"},
-	{Text: "Which is a question word?", Choices: []string{"Where", "Car", "Fast"}, Answer: "Where", Subject: "English", Difficulty: "Easy"},
-	{Text: "Which sentence is correct?", Choices: []string{"He am happy.", "He is happy.", "He are happy."}, Answer: "He is happy.", Subject: "English", Difficulty: "Easy"},
+	{Text: "What is the opposite of 'big'?", Choices: []string{"Small", "Tall", "Long"}, Answer: "Small", Subject: "English", Difficulty: "Easy", Explanation: "Big means large in size. Its opposite is small."},
+	{Text: "Which word is a noun?", Choices: []string{"Run", "Cat", "Blue"}, Answer: "Cat", Subject: "English", Difficulty: "Easy", Explanation: "A noun names a person, place, animal or thing. Run is an action and blue is a color."},
+	{Text: "Which one is a vowel?", Choices: []string{"B", "E", "T"}, Answer: "E", Subject: "English", Difficulty: "Easy", Explanation: "The vowels are A, E, I, O and U. B and T are consonants."},
+	{Text: "What sound does the letter 'B' make?", Choices: []string{"Buh", "Kuh", "Duh"}, Answer: "Buh", Subject: "English", Difficulty: "Easy", Explanation: "B is said with closed lips that pop open: buh, as in ball."},
+	{Text: "Which word rhymes with 'cat'?", Choices: []string{"Dog", "Hat", "Pig"}, Answer: "Hat", Subject: "English", Difficulty: "Easy", Explanation: "Cat and hat both end with the same -at sound."},
+	{Text: "What is the correct article: '___ apple'?", Choices: []string{"A", "An", "The"}, Answer: "An", Subject: "English", Difficulty: "Easy", Explanation: "Use an before a word that starts with a vowel sound, like apple."},
+	{Text: "What comes first in the alphabet?", Choices: []string{"A", "C", "B"}, Answer: "A", Subject: "English", Difficulty: "Easy", Explanation: "The alphabet starts A, B, C."},
+	{Text: "Which is a color word?", Choices: []string{"Red", "Run", "Rat"}, Answer: "Red", Subject: "English", Difficulty: "Easy", Explanation: "Red names a color. Run is an action and rat is an animal."},
+	{Text: "What is the plural of 'dog'?", Choices: []string{"Dog", "Dogs", "Doges"}, Answer: "Dogs", Subject: "English", Difficulty: "Easy", Explanation: "For most words, add -s to mean more than one: dogs."},
+	{Text: "Which is a question word?", Choices: []string{"Where", "Car", "Fast"}, Answer: "Where", Subject: "English", Difficulty: "Easy", Explanation: "Where asks about a place. Questions often start with who, what, where, when or why."},
+	{Text: "Which sentence is correct?", Choices: []string{"He am happy.", "He is happy.", "He are happy."}, Answer: "He is happy.", Subject: "English", Difficulty: "Easy", Explanation: "With he, she or it we use is: He is happy."},
 	// English(Medium)
-	{Text: "Which word is a noun?", Choices: []string{"run", "happy", "apple"}, Answer: "apple", Subject: "English", Difficulty: "Medium"},
-	{Text: "What is the opposite of 'big'?", Choices: []string{"large", "small", "huge"}, Answer: "small", Subject: "English", Difficulty: "Medium"},
-	{Text: "Which word begins with the letter 'B'?", Choices: []string{"cat", "bat", "apple"}, Answer: "bat", Subject: "English", Difficulty: "Medium"},
-	{Text: "Choose the correct plural: one cat, two ___", Choices: []string{"cat", "cats", "cates"}, Answer: "cats", Subject: "English", Difficulty: "Medium"},
-	{Text: "What do you do with your eyes?", Choices: []string{"hear", "see", "smell"}, Answer: "see", Subject: "English", Difficulty: "Medium"},
-	{Text: "Which one is a color?", Choices: []string{"red", "run", "rat"}, Answer: "red", Subject: "English", Difficulty: "Medium"},
-	{Text: "Which word is a verb?", Choices: []string{"sleep", "blue", "table"}, Answer: "sleep", Subject: "English", Difficulty: "Medium"},
-	{Text: "Choose the correct word: The dog is ___ the box.", Choices: []string{"on", "under", "in"}, Answer: "in", Subject: "English", Difficulty: "Medium"},
-	{Text: "What sound does 'ch' make in 'chicken'?", Choices: []string{"sh", "ch", "k"}, Answer: "ch", Subject: "English", Difficulty: "Medium"},
-	{Text: "Which sentence is correct?", Choices: []string{"He run fast.", "He runs fast.", "He running fast."}, Answer: "He runs fast.", Subject: "English", Difficulty: "Medium"},
-	{Text: "What do we call the name of a person?", Choices: []string{"adjective", "noun", "verb"}, Answer: "noun", Subject: "English", Difficulty: "Medium"},
-	{Text: "Pick the correct word: I ___ a book.", Choices: []string{"am", "has", "have"}, Answer: "have", Subject: "English", Difficulty: "Medium"},
-	{Text: "What is the past tense of 'jump'?", Choices: []string{"jumped", "jumping", "jumps"}, Answer: "jumped", Subject: "English", Difficulty: "Medium"},
-	{Text: "Which one is a question word?", Choices: []string{"blue", "what", "book"}, Answer: "what", Subject: "English", Difficulty: "Medium"},
-	{Text: "Which word rhymes with 'cake'?", Choices: []string{"make", "cat", "cup"}, Answer: "make", Subject: "English", Difficulty: "Medium"},
-	{Text: "Choose the correct article: ___ apple is red.", Choices: []string{"A", "An", "The"}, Answer: "An", Subject: "English", Difficulty: "Medium"},
+	{Text: "Which word is a noun?", Choices: []string{"run", "happy", "apple"}, Answer: "apple", Subject: "English", Difficulty: "Medium", Explanation: "A noun names a thing. Apple is a thing; run is an action and happy is a feeling."},
+	{Text: "What is the opposite of 'big'?", Choices: []string{"large", "small", "huge"}, Answer: "small", Subject: "English", Difficulty: "Medium", Explanation: "Large and huge mean the same as big. The opposite is small."},
+	{Text: "Which word begins with the letter 'B'?", Choices: []string{"cat", "bat", "apple"}, Answer: "bat", Subject: "English", Difficulty: "Medium", Explanation: "Bat starts with b. Cat starts with c and apple with a."},
+	{Text: "Choose the correct plural: one cat, two ___", Choices: []string{"cat", "cats", "cates"}, Answer: "cats", Subject: "English", Difficulty: "Medium", Explanation: "Add -s to cat to mean more than one: cats."},
+	{Text: "What do you do with your eyes?", Choices: []string{"hear", "see", "smell"}, Answer: "see", Subject: "English", Difficulty: "Medium", Explanation: "We see with our eyes, hear with our ears and smell with our nose."},
+	{Text: "Which one is a color?", Choices: []string{"red", "run", "rat"}, Answer: "red", Subject: "English", Difficulty: "Medium", Explanation: "Red names a color. Run is an action and rat is an animal."},
+	{Text: "Which word is a verb?", Choices: []string{"sleep", "blue", "table"}, Answer: "sleep", Subject: "English", Difficulty: "Medium", Explanation: "A verb is an action word. Sleep is something you do."},
+	{Text: "Choose the correct word: The dog is ___ the box.", Choices: []string{"on", "under", "in"}, Answer: "in", Subject: "English", Difficulty: "Medium", Explanation: "In means inside something, so the dog is in the box."},
+	{Text: "What sound does 'ch' make in 'chicken'?", Choices: []string{"sh", "ch", "k"}, Answer: "ch", Subject: "English", Difficulty: "Medium", Explanation: "The letters c and h together make the ch sound, as in chicken and chair."},
+	{Text: "Which sentence is correct?", Choices: []string{"He run fast.", "He runs fast.", "He running fast."}, Answer: "He runs fast.", Subject: "English", Difficulty: "Medium", Explanation: "With he, she or it the verb takes an -s: He runs fast."},
+	{Text: "What do we call the name of a person?", Choices: []string{"adjective", "noun", "verb"}, Answer: "noun", Subject: "English", Difficulty: "Medium", Explanation: "A noun names a person, place, animal or thing."},
+	{Text: "Pick the correct word: I ___ a book.", Choices: []string{"am", "has", "have"}, Answer: "have", Subject: "English", Difficulty: "Medium", Explanation: "With I we use have. Has goes with he, she or it."},
+	{Text: "What is the past tense of 'jump'?", Choices: []string{"jumped", "jumping", "jumps"}, Answer: "jumped", Subject: "English", Difficulty: "Medium", Explanation: "Add -ed to show it already happened: jumped."},
+	{Text: "Which one is a question word?", Choices: []string{"blue", "what", "book"}, Answer: "what", Subject: "English", Difficulty: "Medium", Explanation: "What asks about a thing. Blue and book are not question words."},
+	{Text: "Which word rhymes with 'cake'?", Choices: []string{"make", "cat", "cup"}, Answer: "make", Subject: "English", Difficulty: "Medium", Explanation: "Cake and make both end with the -ake sound."},
+	{Text: "Choose the correct article: ___ apple is red.", Choices: []string{"A", "An", "The"}, Answer: "An", Subject: "English", Difficulty: "Medium", Explanation: "Use an before a word that starts with a vowel sound, like apple."},
 	// English(Hard)
-	{Text: "Which word is a noun?", Choices: []string{"run", "happy", "cat"}, Answer: "cat", Subject: "English", Difficulty: "Hard"},
-	{Text: "What is the opposite of 'cold'?", Choices: []string{"hot", "wet", "soft"}, Answer: "hot", Subject: "English", Difficulty: "Hard"},
-	{Text: "Choose the correct sentence.", Choices: []string{"He go to school.", "He goes to school.", "He going to school."}, Answer: "He goes to school.", Subject: "English", Difficulty: "Hard"},
-	{Text: "Which word rhymes with 'hat'?", Choices: []string{"pen", "rat", "dog"}, Answer: "rat", Subject: "English", Difficulty: "Hard"},
-	{Text: "What is the past tense of 'jump'?", Choices: []string{"jumped", "jumping", "jumps"}, Answer: "jumped", Subject: "English", Difficulty: "Hard"},
-	{Text: "Which of these is an adjective?", Choices: []string{"quick", "run", "boy"}, Answer: "quick", Subject: "English", Difficulty: "Hard"},
-	{Text: "Choose the correct word: The bird is ___ the cage.", Choices: []string{"in", "at", "by"}, Answer: "in", Subject: "English", Difficulty: "Hard"},
-	{Text: "Which sentence uses capital letters correctly?", Choices: []string{"i like ice cream.", "I Like Ice Cream.", "I like ice cream."}, Answer: "I like ice cream.", Subject: "English", Difficulty: "Hard"},
-	{Text: "Which one is a question?", Choices: []string{"She is my sister.", "Is she your sister?", "She your sister."}, Answer: "Is she your sister?", Subject: "English", Difficulty: "Hard"},
-	{Text: "Choose the word that starts with a vowel.", Choices: []string{"apple", "ball", "cat"}, Answer: "apple", Subject: "English", Difficulty: "Hard"},
-	{Text: "Which word has the same beginning sound as 'sun'?", Choices: []string{"hat", "sand", "car"}, Answer: "sand", Subject: "English", Difficulty: "Hard"},
-	{Text: "Which is a proper noun?", Choices: []string{"city", "man", "Zamboanga"}, Answer: "Zamboanga", Subject: "English", Difficulty: "Hard"},
-	{Text: "What is the plural of 'baby'?", Choices: []string{"babys", "babies", "babes"}, Answer: "babies", Subject: "English", Difficulty: "Hard"},
-	{Text: "Which word is a verb?", Choices: []string{"happy", "sleep", "blue"}, Answer: "sleep", Subject: "English", Difficulty: "Hard"},
-	{Text: "Which word completes the sentence: She is ___ to the music.", Choices: []string{"listen", "listens", "listening"}, Answer: "listening", Subject: "English", Difficulty: "Hard"},
-	{Text: "Choose the correct punctuation for this sentence: What is your name", Choices: []string{"?", ".", "!"}, Answer: "?", Subject: "English", Difficulty: "Hard"},
-	{Text: "Which word means the same as 'big'?", Choices: []string{"small", "huge", "thin"}, Answer: "huge", Subject: "English", Difficulty: "Hard"},
-	{Text: "What is the correct article: ___ elephant is big.", Choices: []string{"A", "An", "The"}, Answer: "An", Subject: "English", Difficulty: "Hard"},
-	{Text: "Choose the word with a silent letter.", Choices: []string{"knee", "sun", "dog"}, Answer: "knee", Subject: "English", Difficulty: "Hard"},
-	{Text: "Which one is a compound word?", Choices: []string{"sunshine", "sun", "shine"}, Answer: "sunshine", Subject: "English", Difficulty: "Hard"},
-	{Text: "Choose the correct homophone: I went ___ the store.", Choices: []string{"to", "two", "too"}, Answer: "to", Subject: "English", Difficulty: "Hard"},
+	{Text: "Which word is a noun?", Choices: []string{"run", "happy", "cat"}, Answer: "cat", Subject: "English", Difficulty: "Hard", Explanation: "A noun names a thing. Cat is an animal; run is an action and happy is a feeling."},
+	{Text: "What is the opposite of 'cold'?", Choices: []string{"hot", "wet", "soft"}, Answer: "hot", Subject: "English", Difficulty: "Hard", Explanation: "Cold and hot are opposite ends of how warm something is."},
+	{Text: "Choose the correct sentence.", Choices: []string{"He go to school.", "He goes to school.", "He going to school."}, Answer: "He goes to school.", Subject: "English", Difficulty: "Hard", Explanation: "With he, she or it the verb takes an -s: He goes to school."},
+	{Text: "Which word rhymes with 'hat'?", Choices: []string{"pen", "rat", "dog"}, Answer: "rat", Subject: "English", Difficulty: "Hard", Explanation: "Hat and rat both end with the -at sound."},
+	{Text: "What is the past tense of 'jump'?", Choices: []string{"jumped", "jumping", "jumps"}, Answer: "jumped", Subject: "English", Difficulty: "Hard", Explanation: "Add -ed to show it already happened: jumped."},
+	{Text: "Which of these is an adjective?", Choices: []string{"quick", "run", "boy"}, Answer: "quick", Subject: "English", Difficulty: "Hard", Explanation: "An adjective describes something. Quick tells how something moves."},
+	{Text: "Choose the correct word: The bird is ___ the cage.", Choices: []string{"in", "at", "by"}, Answer: "in", Subject: "English", Difficulty: "Hard", Explanation: "In means inside something, so the bird is in the cage."},
+	{Text: "Which sentence uses capital letters correctly?", Choices: []string{"i like ice cream.", "I Like Ice Cream.", "I like ice cream."}, Answer: "I like ice cream.", Subject: "English", Difficulty: "Hard", Explanation: "Capitalize the first word and the word I, but not every word."},
+	{Text: "Which one is a question?", Choices: []string{"She is my sister.", "Is she your sister?", "She your sister."}, Answer: "Is she your sister?", Subject: "English", Difficulty: "Hard", Explanation: "A question asks something and ends with a question mark."},
+	{Text: "Choose the word that starts with a vowel.", Choices: []string{"apple", "ball", "cat"}, Answer: "apple", Subject: "English", Difficulty: "Hard", Explanation: "The vowels are a, e, i, o and u. Apple starts with a."},
+	{Text: "Which word has the same beginning sound as 'sun'?", Choices: []string{"hat", "sand", "car"}, Answer: "sand", Subject: "English", Difficulty: "Hard", Explanation: "Sun and sand both begin with the s sound."},
+	{Text: "Which is a proper noun?", Choices: []string{"city", "man", "Zamboanga"}, Answer: "Zamboanga", Subject: "English", Difficulty: "Hard", Explanation: "A proper noun is the name of one place or person and starts with a capital letter, like Zamboanga."},
+	{Text: "What is the plural of 'baby'?", Choices: []string{"babys", "babies", "babes"}, Answer: "babies", Subject: "English", Difficulty: "Hard", Explanation: "When a word ends in a consonant and y, change the y to i and add -es: babies."},
+	{Text: "Which word is a verb?", Choices: []string{"happy", "sleep", "blue"}, Answer: "sleep", Subject: "English", Difficulty: "Hard", Explanation: "A verb is an action word. Sleep is something you do."},
+	{Text: "Which word completes the sentence: She is ___ to the music.", Choices: []string{"listen", "listens", "listening"}, Answer: "listening", Subject: "English", Difficulty: "Hard", Explanation: "After is, use the -ing form to show it is happening now: listening."},
+	{Text: "Choose the correct punctuation for this sentence: What is your name", Choices: []string{"?", ".", "!"}, Answer: "?", Subject: "English", Difficulty: "Hard", Explanation: "It asks something, so it ends with a question mark."},
+	{Text: "Which word means the same as 'big'?", Choices: []string{"small", "huge", "thin"}, Answer: "huge", Subject: "English", Difficulty: "Hard", Explanation: "Huge means very big. Small and thin are different."},
+	{Text: "What is the correct article: ___ elephant is big.", Choices: []string{"A", "An", "The"}, Answer: "An", Subject: "English", Difficulty: "Hard", Explanation: "Use an before a word that starts with a vowel sound, like elephant."},
+	{Text: "Choose the word with a silent letter.", Choices: []string{"knee", "sun", "dog"}, Answer: "knee", Subject: "English", Difficulty: "Hard", Explanation: "The k in knee is not said. Knee sounds like nee."},
+	{Text: "Which one is a compound word?", Choices: []string{"sunshine", "sun", "shine"}, Answer: "sunshine", Subject: "English", Difficulty: "Hard", Explanation: "A compound word joins two words: sun + shine = sunshine."},
+	{Text: "Choose the correct homophone: I went ___ the store.", Choices: []string{"to", "two", "too"}, Answer: "to", Subject: "English", Difficulty: "Hard", Explanation: "To shows where you go. Two is the number and too means also."},
 	// English(Extreme)
-	{Text: "Which sentence uses the correct past tense?", Choices: []string{"She go to school.", "She went to school.", "She going to school."}, Answer: "She went to school.", Subject: "English", Difficulty: "Extreme"},
-	{Text: "What is the opposite of 'begin'?", Choices: []string{"End", "Start", "Continue"}, Answer: "End", Subject: "English", Difficulty: "Extreme"},
-	{Text: "Which word is a noun?", Choices: []string{"Run", "Blue", "Chair"}, Answer: "Chair", Subject: "English", Difficulty: "Extreme"},
-	{Text: "Choose the correct plural: 'mouse'", Choices: []string{"Mouses", "Mice", "Mouse"}, Answer: "Mice", Subject: "English", Difficulty: "Extreme"},
-	{Text: "What is a synonym for 'happy'?", Choices: []string{"Sad", "Joyful", "Angry"}, Answer: "Joyful", Subject: "English", Difficulty: "Extreme"},
-	{Text: "Which sentence is a question?", Choices: []string{"Where are you going", "Where are you going?", "Where you going."}, Answer: "Where are you going?", Subject: "English", Difficulty: "Extreme"},
-	{Text: "What does 'predict' mean?", Choices: []string{"To look back", "To say what will happen", "To fix something"}, Answer: "To say what will happen", Subject: "English", Difficulty: "Extreme"},
-	{Text: "Choose the correct contraction: 'She is'", Choices: []string{"She's", "Shes", "She is'"}, Answer: "She's", Subject: "English", Difficulty: "Extreme"},
-	{Text: "Which is an adjective?", Choices: []string{"Quickly", "Beautiful", "Run"}, Answer: "Beautiful", Subject: "English", Difficulty: "Extreme"},
-	{Text: "What type of sentence is 'Wow! That's amazing!'", Choices: []string{"Declarative", "Interrogative", "Exclamatory"}, Answer: "Exclamatory", Subject: "English", Difficulty: "Extreme"},
-	{Text: "What punctuation ends a question?", Choices: []string{"!", ".", "?"}, Answer: "?", Subject: "English", Difficulty: "Extreme"},
-	{Text: "Which word means the same as 'tiny'?", Choices: []string{"Large", "Small", "Wide"}, Answer: "Small", Subject: "English", Difficulty: "Extreme"},
-	{Text: "Choose the correct possessive form: The toys of the dog", Choices: []string{"Dogs toy", "Dog's toys", "Dogs' toys"}, Answer: "Dog's toys", Subject: "English", Difficulty: "Extreme"},
-	{Text: "Which word is a verb?", Choices: []string{"Table", "Jump", "Blue"}, Answer: "Jump", Subject: "English", Difficulty: "Extreme"},
-	{Text: "Choose the correct sentence.", Choices: []string{"He goed to school.", "He went to school.", "He going to school."}, Answer: "He went to school.", Subject: "English", Difficulty: "Extreme"},
-	{Text: "What does 'antonym' mean?", Choices: []string{"A word that means the same", "A word that means the opposite", "A describing word"}, Answer: "A word that means the opposite", Subject: "English", Difficulty: "Extreme"},
-	{Text: "Pick the correct homophone: 'Their going to the park.'", Choices: []string{"Their", "There", "They're"}, Answer: "They're", Subject: "English", Difficulty: "Extreme"},
-	{Text: "Which word is spelled correctly?", Choices: []string{"Becaus", "Because", "Becuz"}, Answer: "Because", Subject: "English", Difficulty: "Extreme"},
-	{Text: "Which word best completes the sentence: 'She ___ to the store.'", Choices: []string{"go", "went", "going"}, Answer: "went", Subject: "English", Difficulty: "Extreme"},
-	{Text: "What part of speech is the word 'quickly'?", Choices: []string{"Adjective", "Noun", "Adverb"}, Answer: "Adverb", Subject: "English", Difficulty: "Extreme"},
-	{Text: "Which is a compound word?", Choices: []string{"Sunlight", "Light", "Sun"}, Answer: "Sunlight", Subject: "English", Difficulty: "Extreme"},
-	{Text: "Which of these is a proper noun?", Choices: []string{"city", "store", "London"}, Answer: "London", Subject: "English", Difficulty: "Extreme"},
-	{Text: "What do quotation marks show?", Choices: []string{"An action", "A question", "Someone is speaking"}, Answer: "Someone is speaking", Subject: "English", Difficulty: "Extreme"},
-	{Text: "Choose the sentence with correct punctuation.", Choices: []string{"what time is it", "What time is it.", "What time is it?"}, Answer: "What time is it?", Subject: "English", Difficulty: "Extreme"},
-	{Text: "What does 'prefix' mean?", Choices: []string{"A word at the end", "A word at the start", "A word in the middle"}, Answer: "A word at the start", Subject: "English", Difficulty: "Extreme"},
-	{Text: "Which sentence uses 'there' correctly?", Choices: []string{"There going to the mall.", "The dog is over there.", "There house is big."}, Answer: "The dog is over there.", Subject: "English", Difficulty: "Extreme"},
+	{Text: "Which sentence uses the correct past tense?", Choices: []string{"She go to school.", "She went to school.", "She going to school."}, Answer: "She went to school.", Subject: "English", Difficulty: "Extreme", Explanation: "Go is irregular. Its past tense is went, not goed."},
+	{Text: "What is the opposite of 'begin'?", Choices: []string{"End", "Start", "Continue"}, Answer: "End", Subject: "English", Difficulty: "Extreme", Explanation: "Start means the same as begin. The opposite is end."},
+	{Text: "Which word is a noun?", Choices: []string{"Run", "Blue", "Chair"}, Answer: "Chair", Subject: "English", Difficulty: "Extreme", Explanation: "A noun names a thing. Chair is a thing; run is an action and blue a color."},
+	{Text: "Choose the correct plural: 'mouse'", Choices: []string{"Mouses", "Mice", "Mouse"}, Answer: "Mice", Subject: "English", Difficulty: "Extreme", Explanation: "Mouse has an irregular plural: one mouse, two mice."},
+	{Text: "What is a synonym for 'happy'?", Choices: []string{"Sad", "Joyful", "Angry"}, Answer: "Joyful", Subject: "English", Difficulty: "Extreme", Explanation: "A synonym means the same. Joyful means happy."},
+	{Text: "Which sentence is a question?", Choices: []string{"Where are you going", "Where are you going?", "Where you going."}, Answer: "Where are you going?", Subject: "English", Difficulty: "Extreme", Explanation: "A question uses the right word order and ends with a question mark."},
+	{Text: "What does 'predict' mean?", Choices: []string{"To look back", "To say what will happen", "To fix something"}, Answer: "To say what will happen", Subject: "English", Difficulty: "Extreme", Explanation: "To predict is to say what you think will happen before it does."},
+	{Text: "Choose the correct contraction: 'She is'", Choices: []string{"She's", "Shes", "She is'"}, Answer: "She's", Subject: "English", Difficulty: "Extreme", Explanation: "An apostrophe takes the place of the missing i: she's."},
+	{Text: "Which is an adjective?", Choices: []string{"Quickly", "Beautiful", "Run"}, Answer: "Beautiful", Subject: "English", Difficulty: "Extreme", Explanation: "An adjective describes a noun. Beautiful describes; quickly is an adverb and run a verb."},
+	{Text: "What type of sentence is 'Wow! That's amazing!'", Choices: []string{"Declarative", "Interrogative", "Exclamatory"}, Answer: "Exclamatory", Subject: "English", Difficulty: "Extreme", Explanation: "An exclamatory sentence shows strong feeling and ends with an exclamation mark."},
+	{Text: "What punctuation ends a question?", Choices: []string{"!", ".", "?"}, Answer: "?", Subject: "English", Difficulty: "Extreme", Explanation: "A question ends with a question mark."},
+	{Text: "Which word means the same as 'tiny'?", Choices: []string{"Large", "Small", "Wide"}, Answer: "Small", Subject: "English", Difficulty: "Extreme", Explanation: "Tiny means very small."},
+	{Text: "Choose the correct possessive form: The toys of the dog", Choices: []string{"Dogs toy", "Dog's toys", "Dogs' toys"}, Answer: "Dog's toys", Subject: "English", Difficulty: "Extreme", Explanation: "For one dog, add apostrophe s: the dog's toys."},
+	{Text: "Which word is a verb?", Choices: []string{"Table", "Jump", "Blue"}, Answer: "Jump", Subject: "English", Difficulty: "Extreme", Explanation: "A verb is an action word. Jump is something you do."},
+	{Text: "Choose the correct sentence.", Choices: []string{"He goed to school.", "He went to school.", "He going to school."}, Answer: "He went to school.", Subject: "English", Difficulty: "Extreme", Explanation: "Go is irregular. Its past tense is went, not goed."},
+	{Text: "What does 'antonym' mean?", Choices: []string{"A word that means the same", "A word that means the opposite", "A describing word"}, Answer: "A word that means the opposite", Subject: "English", Difficulty: "Extreme", Explanation: "An antonym is a word with the opposite meaning, like hot and cold."},
+	{Text: "Pick the correct homophone: 'Their going to the park.'", Choices: []string{"Their", "There", "They're"}, Answer: "They're", Subject: "English", Difficulty: "Extreme", Explanation: "They're is short for they are: they are going to the park."},
+	{Text: "Which word is spelled correctly?", Choices: []string{"Becaus", "Because", "Becuz"}, Answer: "Because", Subject: "English", Difficulty: "Extreme", Explanation: "The word is spelled b-e-c-a-u-s-e."},
+	{Text: "Which word best completes the sentence: 'She ___ to the store.'", Choices: []string{"go", "went", "going"}, Answer: "went", Subject: "English", Difficulty: "Extreme", Explanation: "The sentence needs the past tense of go, which is went."},
+	{Text: "What part of speech is the word 'quickly'?", Choices: []string{"Adjective", "Noun", "Adverb"}, Answer: "Adverb", Subject: "English", Difficulty: "Extreme", Explanation: "Quickly tells how something is done. Words like that, often ending in -ly, are adverbs."},
+	{Text: "Which is a compound word?", Choices: []string{"Sunlight", "Light", "Sun"}, Answer: "Sunlight", Subject: "English", Difficulty: "Extreme", Explanation: "A compound word joins two words: sun + light = sunlight."},
+	{Text: "Which of these is a proper noun?", Choices: []string{"city", "store", "London"}, Answer: "London", Subject: "English", Difficulty: "Extreme", Explanation: "London is the name of one city, so it is a proper noun with a capital letter."},
+	{Text: "What do quotation marks show?", Choices: []string{"An action", "A question", "Someone is speaking"}, Answer: "Someone is speaking", Subject: "English", Difficulty: "Extreme", Explanation: "Quotation marks go around the exact words someone says."},
+	{Text: "Choose the sentence with correct punctuation.", Choices: []string{"what time is it", "What time is it.", "What time is it?"}, Answer: "What time is it?", Subject: "English", Difficulty: "Extreme", Explanation: "It starts with a capital letter and, as a question, ends with a question mark."},
+	{Text: "What does 'prefix' mean?", Choices: []string{"A word at the end", "A word at the start", "A word in the middle"}, Answer: "A word at the start", Subject: "English", Difficulty: "Extreme", Explanation: "A prefix is added to the start of a word, like un- in unhappy."},
+	{Text: "Which sentence uses 'there' correctly?", Choices: []string{"There going to the mall.", "The dog is over there.", "There house is big."}, Answer: "The dog is over there.", Subject: "English", Difficulty: "Extreme", Explanation: "There points to a place. They're means they are and their means belonging to them."},
 	// Science(Easy)
-	{Text: "What do we breathe in to live?", Choices: []string{"Water", "Oxygen", "Smoke"}, Answer: "Oxygen", Subject: "Science", Difficulty: "Easy"},
-	{Text: "What do plants need to grow?", Choices: []string{"Milk", "Sunlight", "Juice"}, Answer: "Sunlight", Subject: "Science", Difficulty: "Easy"},
-	{Text: "What is the color of the sky on a clear day?", Choices: []string{"Blue", "Green", "Red"}, Answer: "Blue", Subject: "Science", Difficulty: "Easy"},
-	{Text: "Which of these is a sense organ?", Choices: []string{"Heart", "Ear", "Liver"}, Answer: "Ear", Subject: "Science", Difficulty: "Easy"},
-	{Text: "Which part of the plant is green and makes food?", Choices: []string{"Stem", "Leaf", "Root"}, Answer: "Leaf", Subject: "Science", Difficulty: "Easy"},
-	{Text: "What do fish use to breathe?", Choices: []string{"Nose", "Gills", "Mouth"}, Answer: "Gills", Subject: "Science", Difficulty: "Easy"},
-	{Text: "What do bees make?", Choices: []string{"Milk", "Honey", "Bread"}, Answer: "Honey", Subject: "Science", Difficulty: "Easy"},
-	{Text: "Which animal can fly?", Choices: []string{"Cat", "Dog", "Bird"}, Answer: "Bird", Subject: "Science", Difficulty: "Easy"},
-	{Text: "What do we use to see things?", Choices: []string{"Nose", "Eyes", "Ears"}, Answer: "Eyes", Subject: "Science", Difficulty: "Easy"},
-	{Text: "What do you drink when you are thirsty?", Choices: []string{"Soda", "Juice", "Water"}, Answer: "Water", Subject: "Science", Difficulty: "Easy"},
-	{Text: "What is the sun?", Choices: []string{"A planet", "A star", "A moon"}, Answer: "A star", Subject: "Science", Difficulty: "Easy"},
+	{Text: "What do we breathe in to live?", Choices: []string{"Water", "Oxygen", "Smoke"}, Answer: "Oxygen", Subject: "Science", Difficulty: "Easy", Explanation: "Our lungs take oxygen from the air, and our body needs it to live."},
+	{Text: "What do plants need to grow?", Choices: []string{"Milk", "Sunlight", "Juice"}, Answer: "Sunlight", Subject: "Science", Difficulty: "Easy", Explanation: "Plants use sunlight, water and air to make their own food."},
+	{Text: "What is the color of the sky on a clear day?", Choices: []string{"Blue", "Green", "Red"}, Answer: "Blue", Subject: "Science", Difficulty: "Easy", Explanation: "Sunlight scatters in the air, and blue light scatters the most, so the sky looks blue."},
+	{Text: "Which of these is a sense organ?", Choices: []string{"Heart", "Ear", "Liver"}, Answer: "Ear", Subject: "Science", Difficulty: "Easy", Explanation: "The ear lets us hear, so it is a sense organ. The heart and liver are not."},
+	{Text: "Which part of the plant is green and makes food?", Choices: []string{"Stem", "Leaf", "Root"}, Answer: "Leaf", Subject: "Science", Difficulty: "Easy", Explanation: "Leaves are green and use sunlight to make food for the plant."},
+	{Text: "What do fish use to breathe?", Choices: []string{"Nose", "Gills", "Mouth"}, Answer: "Gills", Subject: "Science", Difficulty: "Easy", Explanation: "Gills take oxygen from the water as it flows over them."},
+	{Text: "What do bees make?", Choices: []string{"Milk", "Honey", "Bread"}, Answer: "Honey", Subject: "Science", Difficulty: "Easy", Explanation: "Bees collect nectar from flowers and turn it into honey."},
+	{Text: "Which animal can fly?", Choices: []string{"Cat", "Dog", "Bird"}, Answer: "Bird", Subject: "Science", Difficulty: "Easy", Explanation: "Birds have wings and feathers for flying. Cats and dogs cannot fly."},
+	{Text: "What do we use to see things?", Choices: []string{"Nose", "Eyes", "Ears"}, Answer: "Eyes", Subject: "Science", Difficulty: "Easy", Explanation: "We see with our eyes, hear with our ears and smell with our nose."},
+	{Text: "What do you drink when you are thirsty?", Choices: []string{"Soda", "Juice", "Water"}, Answer: "Water", Subject: "Science", Difficulty: "Easy", Explanation: "Water is what our body needs most when we are thirsty."},
+	{Text: "What is the sun?", Choices: []string{"A planet", "A star", "A moon"}, Answer: "A star", Subject: "Science", Difficulty: "Easy", Hint: "It shines with its own light, like the dots in the night sky.", Explanation: "The sun makes its own light and heat, which is what stars do. It is the closest star to Earth."},
 	// Science(Medium)
-	{Text: "What do plants need to grow?", Choices: []string{"Milk", "Sunlight", "Sugar"}, Answer: "Sunlight", Subject: "Science", Difficulty: "Medium"},
-	{Text: "Which part of the body helps us see?", Choices: []string{"Ears", "Eyes", "Nose"}, Answer: "Eyes", Subject: "Science", Difficulty: "Medium"},
-	{Text: "What do we breathe in to stay alive?", Choices: []string{"Water", "Oxygen", "Smoke"}, Answer: "Oxygen", Subject: "Science", Difficulty: "Medium"},
-	{Text: "What is the color of healthy leaves?", Choices: []string{"Brown", "Yellow", "Green"}, Answer: "Green", Subject: "Science", Difficulty: "Medium"},
-	{Text: "Which animal lays eggs?", Choices: []string{"Dog", "Cat", "Chicken"}, Answer: "Chicken", Subject: "Science", Difficulty: "Medium"},
-	{Text: "What do fish use to swim?", Choices: []string{"Legs", "Fins", "Wings"}, Answer: "Fins", Subject: "Science", Difficulty: "Medium"},
-	{Text: "Where does the sun go at night?", Choices: []string{"It sleeps", "It hides", "It moves to the other side of the Earth"}, Answer: "It moves to the other side of the Earth", Subject: "Science", Difficulty: "Medium"},
-	{Text: "What do we use to smell things?", Choices: []string{"Mouth", "Hands", "Nose"}, Answer: "Nose", Subject: "Science", Difficulty: "Medium"},
-	{Text: "What helps us hear sounds?", Choices: []string{"Eyes", "Ears", "Hands"}, Answer: "Ears", Subject: "Science", Difficulty: "Medium"},
-	{Text: "Which of these is a living thing?", Choices: []string{"Rock", "Tree", "Car"}, Answer: "Tree", Subject: "Science", Difficulty: "Medium"},
-	{Text: "What do roots do for a plant?", Choices: []string{"Help it breathe", "Hold it in the soil", "Make flowers"}, Answer: "Hold it in the soil", Subject: "Science", Difficulty: "Medium"},
-	{Text: "Which of these can fly?", Choices: []string{"Dog", "Bird", "Snake"}, Answer: "Bird", Subject: "Science", Difficulty: "Medium"},
-	{Text: "What happens when ice is left in the sun?", Choices: []string{"It gets bigger", "It melts", "It turns into dust"}, Answer: "It melts", Subject: "Science", Difficulty: "Medium"},
-	{Text: "Which sense helps you feel a soft teddy bear?", Choices: []string{"Sight", "Touch", "Taste"}, Answer: "Touch", Subject: "Science", Difficulty: "Medium"},
-	{Text: "What covers and protects your body?", Choices: []string{"Bones", "Skin", "Hair"}, Answer: "Skin", Subject: "Science", Difficulty: "Medium"},
-	{Text: "Which of these grows from a seed?", Choices: []string{"Table", "Flower", "Toy"}, Answer: "Flower", Subject: "Science", Difficulty: "Medium"},
+	{Text: "What do plants need to grow?", Choices: []string{"Milk", "Sunlight", "Sugar"}, Answer: "Sunlight", Subject: "Science", Difficulty: "Medium", Explanation: "Plants use sunlight, water and air to make their own food."},
+	{Text: "Which part of the body helps us see?", Choices: []string{"Ears", "Eyes", "Nose"}, Answer: "Eyes", Subject: "Science", Difficulty: "Medium", Explanation: "We see with our eyes, hear with our ears and smell with our nose."},
+	{Text: "What do we breathe in to stay alive?", Choices: []string{"Water", "Oxygen", "Smoke"}, Answer: "Oxygen", Subject: "Science", Difficulty: "Medium", Explanation: "Our lungs take oxygen from the air, and our body needs it to live."},
+	{Text: "What is the color of healthy leaves?", Choices: []string{"Brown", "Yellow", "Green"}, Answer: "Green", Subject: "Science", Difficulty: "Medium", Explanation: "Healthy leaves are full of green chlorophyll, which they use to make food."},
+	{Text: "Which animal lays eggs?", Choices: []string{"Dog", "Cat", "Chicken"}, Answer: "Chicken", Subject: "Science", Difficulty: "Medium", Explanation: "Chickens hatch from eggs. Puppies and kittens are born alive."},
+	{Text: "What do fish use to swim?", Choices: []string{"Legs", "Fins", "Wings"}, Answer: "Fins", Subject: "Science", Difficulty: "Medium", Explanation: "Fish move their fins and tail to push through the water."},
+	{Text: "Where does the sun go at night?", Choices: []string{"It sleeps", "It hides", "It moves to the other side of the Earth"}, Answer: "It moves to the other side of the Earth", Subject: "Science", Difficulty: "Medium", Explanation: "The Earth spins, so at night our side faces away from the sun while the other side has day."},
+	{Text: "What do we use to smell things?", Choices: []string{"Mouth", "Hands", "Nose"}, Answer: "Nose", Subject: "Science", Difficulty: "Medium", Explanation: "We smell with our nose."},
+	{Text: "What helps us hear sounds?", Choices: []string{"Eyes", "Ears", "Hands"}, Answer: "Ears", Subject: "Science", Difficulty: "Medium", Explanation: "Our ears pick up sounds."},
+	{Text: "Which of these is a living thing?", Choices: []string{"Rock", "Tree", "Car"}, Answer: "Tree", Subject: "Science", Difficulty: "Medium", Explanation: "A tree grows, needs water and makes seeds. Rocks and cars do not."},
+	{Text: "What do roots do for a plant?", Choices: []string{"Help it breathe", "Hold it in the soil", "Make flowers"}, Answer: "Hold it in the soil", Subject: "Science", Difficulty: "Medium", Explanation: "Roots hold the plant in the soil and drink up water."},
+	{Text: "Which of these can fly?", Choices: []string{"Dog", "Bird", "Snake"}, Answer: "Bird", Subject: "Science", Difficulty: "Medium", Explanation: "Birds have wings for flying. Dogs and snakes do not."},
+	{Text: "What happens when ice is left in the sun?", Choices: []string{"It gets bigger", "It melts", "It turns into dust"}, Answer: "It melts", Subject: "Science", Difficulty: "Medium", Explanation: "The sun warms the ice and it melts back into water."},
+	{Text: "Which sense helps you feel a soft teddy bear?", Choices: []string{"Sight", "Touch", "Taste"}, Answer: "Touch", Subject: "Science", Difficulty: "Medium", Explanation: "We feel soft and rough things with our sense of touch."},
+	{Text: "What covers and protects your body?", Choices: []string{"Bones", "Skin", "Hair"}, Answer: "Skin", Subject: "Science", Difficulty: "Medium", Explanation: "Skin covers your whole body and keeps germs out."},
+	{Text: "Which of these grows from a seed?", Choices: []string{"Table", "Flower", "Toy"}, Answer: "Flower", Subject: "Science", Difficulty: "Medium", Explanation: "Flowers are plants, and plants grow from seeds."},
 	// Science(Hard)
-	{Text: "Which part of the plant makes food?", Choices: []string{"Roots", "Leaves", "Stem"}, Answer: "Leaves", Subject: "Science", Difficulty: "Hard"},
-	{Text: "What do humans need to breathe?", Choices: []string{"Oxygen", "Carbon Dioxide", "Water"}, Answer: "Oxygen", Subject: "Science", Difficulty: "Hard"},
-	{Text: "Which of these is not a living thing?", Choices: []string{"Tree", "Rock", "Dog"}, Answer: "Rock", Subject: "Science", Difficulty: "Hard"},
-	{Text: "Where does a fish live?", Choices: []string{"Air", "Water", "Land"}, Answer: "Water", Subject: "Science", Difficulty: "Hard"},
-	{Text: "What helps humans see?", Choices: []string{"Ears", "Eyes", "Hands"}, Answer: "Eyes", Subject: "Science", Difficulty: "Hard"},
-	{Text: "What does the Sun give us?", Choices: []string{"Light", "Food", "Water"}, Answer: "Light", Subject: "Science", Difficulty: "Hard"},
-	{Text: "Which animal lays eggs?", Choices: []string{"Cat", "Chicken", "Dog"}, Answer: "Chicken", Subject: "Science", Difficulty: "Hard"},
-	{Text: "Which part of the body helps us smell?", Choices: []string{"Ears", "Nose", "Mouth"}, Answer: "Nose", Subject: "Science", Difficulty: "Hard"},
-	{Text: "What do plants need to grow?", Choices: []string{"Sunlight", "Sugar", "Wind"}, Answer: "Sunlight", Subject: "Science", Difficulty: "Hard"},
-	{Text: "What do we call water that falls from the sky?", Choices: []string{"Snow", "Rain", "Sun"}, Answer: "Rain", Subject: "Science", Difficulty: "Hard"},
-	{Text: "What is the largest organ of the body?", Choices: []string{"Heart", "Skin", "Liver"}, Answer: "Skin", Subject: "Science", Difficulty: "Hard"},
-	{Text: "Which object is in the sky during the day?", Choices: []string{"Moon", "Stars", "Sun"}, Answer: "Sun", Subject: "Science", Difficulty: "Hard"},
-	{Text: "What do bees make?", Choices: []string{"Milk", "Honey", "Sugar"}, Answer: "Honey", Subject: "Science", Difficulty: "Hard"},
-	{Text: "Which of these is a gas?", Choices: []string{"Ice", "Water", "Air"}, Answer: "Air", Subject: "Science", Difficulty: "Hard"},
-	{Text: "What happens to water when it is frozen?", Choices: []string{"It becomes ice", "It disappears", "It boils"}, Answer: "It becomes ice", Subject: "Science", Difficulty: "Hard"},
-	{Text: "What part of the tree is under the ground?", Choices: []string{"Trunk", "Leaves", "Roots"}, Answer: "Roots", Subject: "Science", Difficulty: "Hard"},
-	{Text: "Which of the following can grow?", Choices: []string{"Rock", "Flower", "Spoon"}, Answer: "Flower", Subject: "Science", Difficulty: "Hard"},
-	{Text: "What do you call a young cat?", Choices: []string{"Puppy", "Kitten", "Cub"}, Answer: "Kitten", Subject: "Science", Difficulty: "Hard"},
-	{Text: "What do we call animals that live in water?", Choices: []string{"Insects", "Fish", "Birds"}, Answer: "Fish", Subject: "Science", Difficulty: "Hard"},
-	{Text: "Which one of these animals can fly?", Choices: []string{"Bat", "Dog", "Frog"}, Answer: "Bat", Subject: "Science", Difficulty: "Hard"},
-	{Text: "What do plants give off that helps us breathe?", Choices: []string{"Oxygen", "Smoke", "Dust"}, Answer: "Oxygen", Subject: "Science", Difficulty: "Hard"},
+	{Text: "Which part of the plant makes food?", Choices: []string{"Roots", "Leaves", "Stem"}, Answer: "Leaves", Subject: "Science", Difficulty: "Hard", Explanation: "Leaves use sunlight, water and air to make food for the plant."},
+	{Text: "What do humans need to breathe?", Choices: []string{"Oxygen", "Carbon Dioxide", "Water"}, Answer: "Oxygen", Subject: "Science", Difficulty: "Hard", Explanation: "We breathe in oxygen and breathe out carbon dioxide."},
+	{Text: "Which of these is not a living thing?", Choices: []string{"Tree", "Rock", "Dog"}, Answer: "Rock", Subject: "Science", Difficulty: "Hard", Explanation: "A rock does not grow, eat or breathe. Trees and dogs do."},
+	{Text: "Where does a fish live?", Choices: []string{"Air", "Water", "Land"}, Answer: "Water", Subject: "Science", Difficulty: "Hard", Explanation: "Fish live in water and breathe through their gills."},
+	{Text: "What helps humans see?", Choices: []string{"Ears", "Eyes", "Hands"}, Answer: "Eyes", Subject: "Science", Difficulty: "Hard", Explanation: "We see with our eyes."},
+	{Text: "What does the Sun give us?", Choices: []string{"Light", "Food", "Water"}, Answer: "Light", Subject: "Science", Difficulty: "Hard", Explanation: "The Sun gives us light and heat."},
+	{Text: "Which animal lays eggs?", Choices: []string{"Cat", "Chicken", "Dog"}, Answer: "Chicken", Subject: "Science", Difficulty: "Hard", Explanation: "Chickens hatch from eggs. Puppies and kittens are born alive."},
+	{Text: "Which part of the body helps us smell?", Choices: []string{"Ears", "Nose", "Mouth"}, Answer: "Nose", Subject: "Science", Difficulty: "Hard", Explanation: "We smell with our nose."},
+	{Text: "What do plants need to grow?", Choices: []string{"Sunlight", "Sugar", "Wind"}, Answer: "Sunlight", Subject: "Science", Difficulty: "Hard", Explanation: "Plants use sunlight, water and air to make their own food."},
+	{Text: "What do we call water that falls from the sky?", Choices: []string{"Snow", "Rain", "Sun"}, Answer: "Rain", Subject: "Science", Difficulty: "Hard", Explanation: "Water falling from clouds as drops is rain. Frozen flakes are snow."},
+	{Text: "What is the largest organ of the body?", Choices: []string{"Heart", "Skin", "Liver"}, Answer: "Skin", Subject: "Science", Difficulty: "Hard", Explanation: "Skin covers the whole body, which makes it the largest organ."},
+	{Text: "Which object is in the sky during the day?", Choices: []string{"Moon", "Stars", "Sun"}, Answer: "Sun", Subject: "Science", Difficulty: "Hard", Explanation: "We see the Sun during the day. Stars are hidden by its light."},
+	{Text: "What do bees make?", Choices: []string{"Milk", "Honey", "Sugar"}, Answer: "Honey", Subject: "Science", Difficulty: "Hard", Explanation: "Bees collect nectar from flowers and turn it into honey."},
+	{Text: "Which of these is a gas?", Choices: []string{"Ice", "Water", "Air"}, Answer: "Air", Subject: "Science", Difficulty: "Hard", Explanation: "Air is a gas. Ice is a solid and water is a liquid."},
+	{Text: "What happens to water when it is frozen?", Choices: []string{"It becomes ice", "It disappears", "It boils"}, Answer: "It becomes ice", Subject: "Science", Difficulty: "Hard", Explanation: "Water turns solid when it gets cold enough, and solid water is ice."},
+	{Text: "What part of the tree is under the ground?", Choices: []string{"Trunk", "Leaves", "Roots"}, Answer: "Roots", Subject: "Science", Difficulty: "Hard", Explanation: "Roots grow under the ground and hold the tree up."},
+	{Text: "Which of the following can grow?", Choices: []string{"Rock", "Flower", "Spoon"}, Answer: "Flower", Subject: "Science", Difficulty: "Hard", Explanation: "A flower is a living thing, and living things grow."},
+	{Text: "What do you call a young cat?", Choices: []string{"Puppy", "Kitten", "Cub"}, Answer: "Kitten", Subject: "Science", Difficulty: "Hard", Explanation: "A young cat is a kitten. A young dog is a puppy."},
+	{Text: "What do we call animals that live in water?", Choices: []string{"Insects", "Fish", "Birds"}, Answer: "Fish", Subject: "Science", Difficulty: "Hard", Explanation: "Fish live in water and breathe through their gills."},
+	{Text: "Which one of these animals can fly?", Choices: []string{"Bat", "Dog", "Frog"}, Answer: "Bat", Subject: "Science", Difficulty: "Hard", Explanation: "A bat has wings made of skin and can fly."},
+	{Text: "What do plants give off that helps us breathe?", Choices: []string{"Oxygen", "Smoke", "Dust"}, Answer: "Oxygen", Subject: "Science", Difficulty: "Hard", Explanation: "Plants give off oxygen when they make their food."},
 	// Science(Extreme)
-	{Text: "What part of the plant makes food?", Choices: []string{"Leaf", "Root", "Stem"}, Answer: "Leaf", Subject: "Science", Difficulty: "Extreme"},
-	{Text: "What do humans need to breathe?", Choices: []string{"Oxygen", "Carbon dioxide", "Water"}, Answer: "Oxygen", Subject: "Science", Difficulty: "Extreme"},
-	{Text: "What is the hardest part of your body?", Choices: []string{"Skin", "Bone", "Tooth"}, Answer: "Tooth", Subject: "Science", Difficulty: "Extreme"},
-	{Text: "Which planet is closest to the Sun?", Choices: []string{"Earth", "Mars", "Mercury"}, Answer: "Mercury", Subject: "Science", Difficulty: "Extreme"},
-	{Text: "What gas do plants give off?", Choices: []string{"Oxygen", "Nitrogen", "Hydrogen"}, Answer: "Oxygen", Subject: "Science", Difficulty: "Extreme"},
-	{Text: "What do you call water when it turns into gas?", Choices: []string{"Ice", "Steam", "Snow"}, Answer: "Steam", Subject: "Science", Difficulty: "Extreme"},
-	{Text: "How many legs does an insect have?", Choices: []string{"4", "6", "8"}, Answer: "6", Subject: "Science", Difficulty: "Extreme"},
-	{Text: "What is the function of roots in plants?", Choices: []string{"Make food", "Absorb water", "Help breathe"}, Answer: "Absorb water", Subject: "Science", Difficulty: "Extreme"},
-	{Text: "Which sense organ helps us smell?", Choices: []string{"Eye", "Ear", "Nose"}, Answer: "Nose", Subject: "Science", Difficulty: "Extreme"},
-	{Text: "What is the smallest unit of life?", Choices: []string{"Organ", "Cell", "Tissue"}, Answer: "Cell", Subject: "Science", Difficulty: "Extreme"},
-	{Text: "Which part of the body helps pump blood?", Choices: []string{"Lung", "Heart", "Stomach"}, Answer: "Heart", Subject: "Science", Difficulty: "Extreme"},
-	{Text: "What helps plants grow toward light?", Choices: []string{"Phototropism", "Photosynthesis", "Evaporation"}, Answer: "Phototropism", Subject: "Science", Difficulty: "Extreme"},
-	{Text: "Which liquid helps digest food in the stomach?", Choices: []string{"Saliva", "Acid", "Water"}, Answer: "Acid", Subject: "Science", Difficulty: "Extreme"},
-	{Text: "What happens when the sun sets?", Choices: []string{"Morning", "Evening", "Afternoon"}, Answer: "Evening", Subject: "Science", Difficulty: "Extreme"},
-	{Text: "What tool do you use to look at stars?", Choices: []string{"Microscope", "Binoculars", "Telescope"}, Answer: "Telescope", Subject: "Science", Difficulty: "Extreme"},
-	{Text: "Which planet has rings?", Choices: []string{"Venus", "Saturn", "Mars"}, Answer: "Saturn", Subject: "Science", Difficulty: "Extreme"},
-	{Text: "What is rain made of?", Choices: []string{"Dust", "Water", "Gas"}, Answer: "Water", Subject: "Science", Difficulty: "Extreme"},
-	{Text: "What makes the moon shine at night?", Choices: []string{"It glows", "It reflects sunlight", "It burns gas"}, Answer: "It reflects sunlight", Subject: "Science", Difficulty: "Extreme"},
-	{Text: "What animal lays eggs and can fly?", Choices: []string{"Bat", "Eagle", "Dog"}, Answer: "Eagle", Subject: "Science", Difficulty: "Extreme"},
-	{Text: "Where do fish get oxygen?", Choices: []string{"Air", "Water", "Sand"}, Answer: "Water", Subject: "Science", Difficulty: "Extreme"},
-	{Text: "What state of matter is steam?", Choices: []string{"Solid", "Liquid", "Gas"}, Answer: "Gas", Subject: "Science", Difficulty: "Extreme"},
-	{Text: "Which part of the plant holds it upright?", Choices: []string{"Leaf", "Stem", "Flower"}, Answer: "Stem", Subject: "Science", Difficulty: "Extreme"},
-	{Text: "Which animal has scales and lays eggs?", Choices: []string{"Dog", "Lizard", "Frog"}, Answer: "Lizard", Subject: "Science", Difficulty: "Extreme"},
-	{Text: "Why do we see lightning before thunder?", Choices: []string{"Light is faster than sound", "Sound is louder", "Thunder comes first"}, Answer: "Light is faster than sound", Subject: "Science", Difficulty: "Extreme"},
-	{Text: "What are clouds made of?", Choices: []string{"Dust", "Water droplets", "Air"}, Answer: "Water droplets", Subject: "Science", Difficulty: "Extreme"},
-	{Text: "What do birds use to fly?", Choices: []string{"Feet", "Wings", "Beak"}, Answer: "Wings", Subject: "Science", Difficulty: "Extreme"},
+	{Text: "What part of the plant makes food?", Choices: []string{"Leaf", "Root", "Stem"}, Answer: "Leaf", Subject: "Science", Difficulty: "Extreme", Explanation: "Leaves use sunlight, water and air to make food. This is photosynthesis."},
+	{Text: "What do humans need to breathe?", Choices: []string{"Oxygen", "Carbon dioxide", "Water"}, Answer: "Oxygen", Subject: "Science", Difficulty: "Extreme", Explanation: "We breathe in oxygen and breathe out carbon dioxide."},
+	{Text: "What is the hardest part of your body?", Choices: []string{"Skin", "Bone", "Tooth"}, Answer: "Tooth", Subject: "Science", Difficulty: "Extreme", Explanation: "Tooth enamel is even harder than bone."},
+	{Text: "Which planet is closest to the Sun?", Choices: []string{"Earth", "Mars", "Mercury"}, Answer: "Mercury", Subject: "Science", Difficulty: "Extreme", Hint: "It is named after the fast messenger of the Roman gods.", Explanation: "The order from the Sun starts Mercury, Venus, Earth, Mars."},
+	{Text: "What gas do plants give off?", Choices: []string{"Oxygen", "Nitrogen", "Hydrogen"}, Answer: "Oxygen", Subject: "Science", Difficulty: "Extreme", Explanation: "Plants take in carbon dioxide and give off oxygen when they make food."},
+	{Text: "What do you call water when it turns into gas?", Choices: []string{"Ice", "Steam", "Snow"}, Answer: "Steam", Subject: "Science", Difficulty: "Extreme", Explanation: "Boiling water turns into a gas called steam."},
+	{Text: "How many legs does an insect have?", Choices: []string{"4", "6", "8"}, Answer: "6", Subject: "Science", Difficulty: "Extreme", Hint: "Spiders have 8 legs, but spiders are not insects.", Explanation: "All insects have six legs and three body parts: head, thorax and abdomen."},
+	{Text: "What is the function of roots in plants?", Choices: []string{"Make food", "Absorb water", "Help breathe"}, Answer: "Absorb water", Subject: "Science", Difficulty: "Extreme", Explanation: "Roots take in water and minerals from the soil."},
+	{Text: "Which sense organ helps us smell?", Choices: []string{"Eye", "Ear", "Nose"}, Answer: "Nose", Subject: "Science", Difficulty: "Extreme", Explanation: "We smell with our nose."},
+	{Text: "What is the smallest unit of life?", Choices: []string{"Organ", "Cell", "Tissue"}, Answer: "Cell", Subject: "Science", Difficulty: "Extreme", Explanation: "All living things are made of cells. Cells form tissues, and tissues form organs."},
+	{Text: "Which part of the body helps pump blood?", Choices: []string{"Lung", "Heart", "Stomach"}, Answer: "Heart", Subject: "Science", Difficulty: "Extreme", Explanation: "The heart is a muscle that pumps blood around the body."},
+	{Text: "What helps plants grow toward light?", Choices: []string{"Phototropism", "Photosynthesis", "Evaporation"}, Answer: "Phototropism", Subject: "Science", Difficulty: "Extreme", Explanation: "Phototropism makes plants bend toward light. Photosynthesis is how they make food."},
+	{Text: "Which liquid helps digest food in the stomach?", Choices: []string{"Saliva", "Acid", "Water"}, Answer: "Acid", Subject: "Science", Difficulty: "Extreme", Explanation: "The stomach makes acid that breaks down food."},
+	{Text: "What happens when the sun sets?", Choices: []string{"Morning", "Evening", "Afternoon"}, Answer: "Evening", Subject: "Science", Difficulty: "Extreme", Explanation: "The sun sets at the end of the day, in the evening."},
+	{Text: "What tool do you use to look at stars?", Choices: []string{"Microscope", "Binoculars", "Telescope"}, Answer: "Telescope", Subject: "Science", Difficulty: "Extreme", Explanation: "A telescope makes faraway things like stars look bigger. A microscope is for tiny things."},
+	{Text: "Which planet has rings?", Choices: []string{"Venus", "Saturn", "Mars"}, Answer: "Saturn", Subject: "Science", Difficulty: "Extreme", Hint: "It is the sixth planet from the Sun.", Explanation: "Saturn's rings are made of ice and rock and are the brightest in the solar system."},
+	{Text: "What is rain made of?", Choices: []string{"Dust", "Water", "Gas"}, Answer: "Water", Subject: "Science", Difficulty: "Extreme", Explanation: "Rain is drops of water that fall from clouds."},
+	{Text: "What makes the moon shine at night?", Choices: []string{"It glows", "It reflects sunlight", "It burns gas"}, Answer: "It reflects sunlight", Subject: "Science", Difficulty: "Extreme", Explanation: "The moon makes no light of its own. It reflects light from the sun."},
+	{Text: "What animal lays eggs and can fly?", Choices: []string{"Bat", "Eagle", "Dog"}, Answer: "Eagle", Subject: "Science", Difficulty: "Extreme", Explanation: "An eagle is a bird, and birds lay eggs. Bats fly but give birth to live young."},
+	{Text: "Where do fish get oxygen?", Choices: []string{"Air", "Water", "Sand"}, Answer: "Water", Subject: "Science", Difficulty: "Extreme", Explanation: "Fish take oxygen from the water with their gills."},
+	{Text: "What state of matter is steam?", Choices: []string{"Solid", "Liquid", "Gas"}, Answer: "Gas", Subject: "Science", Difficulty: "Extreme", Explanation: "Steam is water that has boiled into a gas."},
+	{Text: "Which part of the plant holds it upright?", Choices: []string{"Leaf", "Stem", "Flower"}, Answer: "Stem", Subject: "Science", Difficulty: "Extreme", Explanation: "The stem holds the plant up and carries water to the leaves."},
+	{Text: "Which animal has scales and lays eggs?", Choices: []string{"Dog", "Lizard", "Frog"}, Answer: "Lizard", Subject: "Science", Difficulty: "Extreme", Explanation: "A lizard is a reptile with dry scales. Frogs have smooth skin."},
+	{Text: "Why do we see lightning before thunder?", Choices: []string{"Light is faster than sound", "Sound is louder", "Thunder comes first"}, Answer: "Light is faster than sound", Subject: "Science", Difficulty: "Extreme", Explanation: "Light travels much faster than sound, so the flash reaches us first."},
+	{Text: "What are clouds made of?", Choices: []string{"Dust", "Water droplets", "Air"}, Answer: "Water droplets", Subject: "Science", Difficulty: "Extreme", Explanation: "Clouds are made of tiny water droplets floating in the air."},
+	{Text: "What do birds use to fly?", Choices: []string{"Feet", "Wings", "Beak"}, Answer: "Wings", Subject: "Science", Difficulty: "Extreme", Explanation: "Birds flap their wings to fly."},
 	// Filipino(Easy)
-	{Text: "Ano ang pambansang prutas ng Pilipinas?", Choices: []string{"Mangga", "Saging", "Pakwan"}, Answer: "Mangga", Subject: "Filipino", Difficulty: "Easy"},
-	{Text: "Ano ang kulay ng dahon?", Choices: []string{"Pula", "Berde", "Dilaw"}, Answer: "Berde", Subject: "Filipino", Difficulty: "Easy"},
-	{Text: "Anong tunog ang ginagawa ng aso?", Choices: []string{"Moo", "Kokak", "Aw aw"}, Answer: "Aw aw", Subject: "Filipino", Difficulty: "Easy"},
-	{Text: "Anong bahagi ng katawan ang ginagamit sa paglakad?", Choices: []string{"Kamay", "Paa", "Ulo"}, Answer: "Paa", Subject: "Filipino", Difficulty: "Easy"},
-	{Text: "Ano ang iniinom ng sanggol?", Choices: []string{"Tubig", "Kape", "Gatas"}, Answer: "Gatas", Subject: "Filipino", Difficulty: "Easy"},
-	{Text: "Anong hayop ang may mahabang leeg?", Choices: []string{"Aso", "Giraffe", "Pusa"}, Answer: "Giraffe", Subject: "Filipino", Difficulty: "Easy"},
-	{Text: "Ano ang tawag sa tatay ng iyong nanay?", Choices: []string{"Lolo", "Tito", "Kuya"}, Answer: "Lolo", Subject: "Filipino", Difficulty: "Easy"},
-	{Text: "Anong araw ang kasunod ng Lunes?", Choices: []string{"Sabado", "Martes", "Linggo"}, Answer: "Martes", Subject: "Filipino", Difficulty: "Easy"},
-	{Text: "Ano ang tawag sa kulay ng langit tuwing umaga?", Choices: []string{"Itim", "Berde", "Bughaw"}, Answer: "Bughaw", Subject: "Filipino", Difficulty: "Easy"},
-	{Text: "Saan pumapasok ang mga bata para mag-aral?", Choices: []string{"Palengke", "Sinehan", "Paaralan"}, Answer: "Paaralan", Subject: "Filipino", Difficulty: "Easy"},
-	{Text: "Anong prutas ang may maraming mata?", Choices: []string{"Saging", "Pinya", "Mangga"}, Answer: "Pinya", Subject: "Filipino", Difficulty: "Easy"},
+	{Text: "Ano ang pambansang prutas ng Pilipinas?", Choices: []string{"Mangga", "Saging", "Pakwan"}, Answer: "Mangga", Subject: "Filipino", Difficulty: "Easy", Explanation: "Ang mangga ang pambansang prutas ng Pilipinas."},
+	{Text: "Ano ang kulay ng dahon?", Choices: []string{"Pula", "Berde", "Dilaw"}, Answer: "Berde", Subject: "Filipino", Difficulty: "Easy", Explanation: "Berde ang kulay ng malusog na dahon."},
+	{Text: "Anong tunog ang ginagawa ng aso?", Choices: []string{"Moo", "Kokak", "Aw aw"}, Answer: "Aw aw", Subject: "Filipino", Difficulty: "Easy", Explanation: "Tumatahol ang aso: aw aw. Ang moo ay sa baka at kokak sa palaka."},
+	{Text: "Anong bahagi ng katawan ang ginagamit sa paglakad?", Choices: []string{"Kamay", "Paa", "Ulo"}, Answer: "Paa", Subject: "Filipino", Difficulty: "Easy", Explanation: "Paa ang ginagamit natin sa paglakad."},
+	{Text: "Ano ang iniinom ng sanggol?", Choices: []string{"Tubig", "Kape", "Gatas"}, Answer: "Gatas", Subject: "Filipino", Difficulty: "Easy", Explanation: "Gatas ang iniinom ng sanggol."},
+	{Text: "Anong hayop ang may mahabang leeg?", Choices: []string{"Aso", "Giraffe", "Pusa"}, Answer: "Giraffe", Subject: "Filipino", Difficulty: "Easy", Explanation: "Ang giraffe ang may pinakamahabang leeg sa lahat ng hayop."},
+	{Text: "Ano ang tawag sa tatay ng iyong nanay?", Choices: []string{"Lolo", "Tito", "Kuya"}, Answer: "Lolo", Subject: "Filipino", Difficulty: "Easy", Explanation: "Ang tatay ng nanay o tatay mo ay iyong lolo."},
+	{Text: "Anong araw ang kasunod ng Lunes?", Choices: []string{"Sabado", "Martes", "Linggo"}, Answer: "Martes", Subject: "Filipino", Difficulty: "Easy", Explanation: "Lunes, Martes, Miyerkules: Martes ang kasunod ng Lunes."},
+	{Text: "Ano ang tawag sa kulay ng langit tuwing umaga?", Choices: []string{"Itim", "Berde", "Bughaw"}, Answer: "Bughaw", Subject: "Filipino", Difficulty: "Easy", Explanation: "Bughaw o asul ang langit kapag maliwanag ang umaga."},
+	{Text: "Saan pumapasok ang mga bata para mag-aral?", Choices: []string{"Palengke", "Sinehan", "Paaralan"}, Answer: "Paaralan", Subject: "Filipino", Difficulty: "Easy", Explanation: "Sa paaralan nag-aaral ang mga bata."},
+	{Text: "Anong prutas ang may maraming mata?", Choices: []string{"Saging", "Pinya", "Mangga"}, Answer: "Pinya", Subject: "Filipino", Difficulty: "Easy", Explanation: "Ang pinya ang may maraming mata sa balat nito."},
 	// Filipino(Medium)
-	{Text: "Ano ang unang titik ng salitang 'aso'?", Choices: []string{"a", "o", "s"}, Answer: "a", Subject: "Filipino", Difficulty: "Medium"},
-	{Text: "Ano ang tawag sa larawan ng araw, ulap, at ulan?", Choices: []string{"Panahon", "Pagkain", "Hayop"}, Answer: "Panahon", Subject: "Filipino", Difficulty: "Medium"},
-	{Text: "Ano ang kabaligtaran ng salitang 'malaki'?", Choices: []string{"mahaba", "maliit", "mabigat"}, Answer: "maliit", Subject: "Filipino", Difficulty: "Medium"},
-	{Text: "Piliin ang salitang nagsisimula sa letrang 'b'.", Choices: []string{"aso", "bola", "gatas"}, Answer: "bola", Subject: "Filipino", Difficulty: "Medium"},
-	{Text: "Anong tunog ang naririnig sa dulo ng salitang 'gabi'?", Choices: []string{"a", "i", "g"}, Answer: "i", Subject: "Filipino", Difficulty: "Medium"},
-	{Text: "Alin sa mga sumusunod ang bahagi ng katawan?", Choices: []string{"kamay", "mesa", "bintana"}, Answer: "kamay", Subject: "Filipino", Difficulty: "Medium"},
-	{Text: "Pumili ng tamang sagot: Ang langit ay ____.", Choices: []string{"berde", "asul", "dilaw"}, Answer: "asul", Subject: "Filipino", Difficulty: "Medium"},
-	{Text: "Ano ang kasunod ng titik 'k' sa alpabeto?", Choices: []string{"j", "l", "m"}, Answer: "l", Subject: "Filipino", Difficulty: "Medium"},
-	{Text: "Alin sa mga ito ang hayop?", Choices: []string{"kabayo", "sapatos", "upuan"}, Answer: "kabayo", Subject: "Filipino", Difficulty: "Medium"},
-	{Text: "Ano ang tamang pantig ng salitang 'bahay'?", Choices: []string{"ba-hay", "bah-ay", "baha-y"}, Answer: "ba-hay", Subject: "Filipino", Difficulty: "Medium"},
-	{Text: "Piliin ang salitang pareho ang tunog sa 'bata'.", Choices: []string{"mata", "mesa", "pusa"}, Answer: "mata", Subject: "Filipino", Difficulty: "Medium"},
-	{Text: "Ano ang dapat gamitin sa dulo ng pangungusap?", Choices: []string{"tuldok", "kwit", "kudlit"}, Answer: "tuldok", Subject: "Filipino", Difficulty: "Medium"},
-	{Text: "Saan ginagamit ang walis?", Choices: []string{"sa pagkain", "sa pagsusulat", "sa paglinis"}, Answer: "sa paglinis", Subject: "Filipino", Difficulty: "Medium"},
-	{Text: "Alin sa mga ito ang hindi kulay?", Choices: []string{"pula", "dahon", "asul"}, Answer: "dahon", Subject: "Filipino", Difficulty: "Medium"},
-	{Text: "Pumili ng salitang tumutukoy sa prutas.", Choices: []string{"saging", "lapis", "silya"}, Answer: "saging", Subject: "Filipino", Difficulty: "Medium"},
-	{Text: "Ano ang salitang angkop sa 'Ang ibon ay ___ sa langit.'?", Choices: []string{"lumilipad", "kumakain", "natutulog"}, Answer: "lumilipad", Subject: "Filipino", Difficulty: "Medium"},
+	{Text: "Ano ang unang titik ng salitang 'aso'?", Choices: []string{"a", "o", "s"}, Answer: "a", Subject: "Filipino", Difficulty: "Medium", Explanation: "Ang aso ay nagsisimula sa titik a."},
+	{Text: "Ano ang tawag sa larawan ng araw, ulap, at ulan?", Choices: []string{"Panahon", "Pagkain", "Hayop"}, Answer: "Panahon", Subject: "Filipino", Difficulty: "Medium", Explanation: "Ang araw, ulap at ulan ay nagsasabi ng panahon."},
+	{Text: "Ano ang kabaligtaran ng salitang 'malaki'?", Choices: []string{"mahaba", "maliit", "mabigat"}, Answer: "maliit", Subject: "Filipino", Difficulty: "Medium", Explanation: "Ang kabaligtaran ng malaki ay maliit."},
+	{Text: "Piliin ang salitang nagsisimula sa letrang 'b'.", Choices: []string{"aso", "bola", "gatas"}, Answer: "bola", Subject: "Filipino", Difficulty: "Medium", Explanation: "Ang bola ay nagsisimula sa b."},
+	{Text: "Anong tunog ang naririnig sa dulo ng salitang 'gabi'?", Choices: []string{"a", "i", "g"}, Answer: "i", Subject: "Filipino", Difficulty: "Medium", Explanation: "Ang gabi ay nagtatapos sa tunog na i."},
+	{Text: "Alin sa mga sumusunod ang bahagi ng katawan?", Choices: []string{"kamay", "mesa", "bintana"}, Answer: "kamay", Subject: "Filipino", Difficulty: "Medium", Explanation: "Ang kamay ay bahagi ng katawan. Ang mesa at bintana ay mga bagay."},
+	{Text: "Pumili ng tamang sagot: Ang langit ay ____.", Choices: []string{"berde", "asul", "dilaw"}, Answer: "asul", Subject: "Filipino", Difficulty: "Medium", Explanation: "Asul ang langit kapag maaliwalas ang panahon."},
+	{Text: "Ano ang kasunod ng titik 'k' sa alpabeto?", Choices: []string{"j", "l", "m"}, Answer: "l", Subject: "Filipino", Difficulty: "Medium", Explanation: "Sa alpabeto: j, k, l. Ang l ang kasunod ng k."},
+	{Text: "Alin sa mga ito ang hayop?", Choices: []string{"kabayo", "sapatos", "upuan"}, Answer: "kabayo", Subject: "Filipino", Difficulty: "Medium", Explanation: "Ang kabayo ay hayop. Ang sapatos at upuan ay mga bagay."},
+	{Text: "Ano ang tamang pantig ng salitang 'bahay'?", Choices: []string{"ba-hay", "bah-ay", "baha-y"}, Answer: "ba-hay", Subject: "Filipino", Difficulty: "Medium", Explanation: "Ang bahay ay may dalawang pantig: ba-hay."},
+	{Text: "Piliin ang salitang pareho ang tunog sa 'bata'.", Choices: []string{"mata", "mesa", "pusa"}, Answer: "mata", Subject: "Filipino", Difficulty: "Medium", Explanation: "Ang bata at mata ay parehong nagtatapos sa -ata."},
+	{Text: "Ano ang dapat gamitin sa dulo ng pangungusap?", Choices: []string{"tuldok", "kwit", "kudlit"}, Answer: "tuldok", Subject: "Filipino", Difficulty: "Medium", Explanation: "Tuldok ang inilalagay sa dulo ng pangungusap na nagsasalaysay."},
+	{Text: "Saan ginagamit ang walis?", Choices: []string{"sa pagkain", "sa pagsusulat", "sa paglinis"}, Answer: "sa paglinis", Subject: "Filipino", Difficulty: "Medium", Explanation: "Ginagamit ang walis sa paglilinis ng sahig."},
+	{Text: "Alin sa mga ito ang hindi kulay?", Choices: []string{"pula", "dahon", "asul"}, Answer: "dahon", Subject: "Filipino", Difficulty: "Medium", Explanation: "Ang dahon ay bahagi ng halaman, hindi kulay. Kulay ang pula at asul."},
+	{Text: "Pumili ng salitang tumutukoy sa prutas.", Choices: []string{"saging", "lapis", "silya"}, Answer: "saging", Subject: "Filipino", Difficulty: "Medium", Explanation: "Ang saging ay prutas. Ang lapis at silya ay mga bagay."},
+	{Text: "Ano ang salitang angkop sa 'Ang ibon ay ___ sa langit.'?", Choices: []string{"lumilipad", "kumakain", "natutulog"}, Answer: "lumilipad", Subject: "Filipino", Difficulty: "Medium", Explanation: "Lumilipad ang ibon sa langit gamit ang pakpak nito."},
 	// Filipino(Hard)
-	{Text: "Ano ang tamang baybay ng salitang 'maganda'?", Choices: []string{"maganda", "magannda", "magnda"}, Answer: "maganda", Subject: "Filipino", Difficulty: "Hard"},
-	{Text: "Ano ang kasalungat ng salitang 'malaki'?", Choices: []string{"mataas", "maliit", "mahaba"}, Answer: "maliit", Subject: "Filipino", Difficulty: "Hard"},
-	{Text: "Pumili ng pangngalan: Si Ana ay kumain ng mangga.", Choices: []string{"kumain", "Ana", "mangga"}, Answer: "Ana", Subject: "Filipino", Difficulty: "Hard"},
-	{Text: "Anong bahagi ng katawan ang ginagamit sa pagdinig?", Choices: []string{"mata", "tainga", "ilong"}, Answer: "tainga", Subject: "Filipino", Difficulty: "Hard"},
-	{Text: "Anong tunog ang nagsisimula sa titik 'B'?", Choices: []string{"aso", "bola", "gatas"}, Answer: "bola", Subject: "Filipino", Difficulty: "Hard"},
-	{Text: "Alin ang tamang gamit ng 'ng'?", Choices: []string{"Kumain ng saging.", "Ng umaga ay malamig.", "Ng bahay ay malaki."}, Answer: "Kumain ng saging.", Subject: "Filipino", Difficulty: "Hard"},
-	{Text: "Piliin ang salitang may diptonggo.", Choices: []string{"bata", "gabi", "araw"}, Answer: "araw", Subject: "Filipino", Difficulty: "Hard"},
-	{Text: "Ano ang sagot sa bugtong: Isang balong malalim, punong-puno ng patalim?", Choices: []string{"bibig", "balon", "ilong"}, Answer: "bibig", Subject: "Filipino", Difficulty: "Hard"},
-	{Text: "Alin ang salitang pantig na 'ka-la-ba-sa'?", Choices: []string{"kalabasa", "kamatis", "kahel"}, Answer: "kalabasa", Subject: "Filipino", Difficulty: "Hard"},
-	{Text: "Piliin ang tamang gamit ng 'ang'.", Choices: []string{"Ang bahay ay malaki.", "Bahay ang malaki.", "Malaki ang bahay."}, Answer: "Ang bahay ay malaki.", Subject: "Filipino", Difficulty: "Hard"},
-	{Text: "Alin sa mga sumusunod ang pang-uri?", Choices: []string{"kumain", "mataas", "siya"}, Answer: "mataas", Subject: "Filipino", Difficulty: "Hard"},
-	{Text: "Piliin ang tamang baybay: anák, anak, ana'k", Choices: []string{"anak", "anák", "ana'k"}, Answer: "anak", Subject: "Filipino", Difficulty: "Hard"},
-	{Text: "Ano ang kasalungat ng 'maingay'?", Choices: []string{"matahimik", "masaya", "malakas"}, Answer: "matahimik", Subject: "Filipino", Difficulty: "Hard"},
-	{Text: "Anong bahagi ng pangungusap ang 'umalis si kuya'?", Choices: []string{"simuno", "panaguri", "pangatnig"}, Answer: "panaguri", Subject: "Filipino", Difficulty: "Hard"},
-	{Text: "Alin ang panghalip panao?", Choices: []string{"siya", "bata", "bahay"}, Answer: "siya", Subject: "Filipino", Difficulty: "Hard"},
-	{Text: "Ano ang kahulugan ng 'matimtiman'?", Choices: []string{"malakas", "matahimik", "masunurin"}, Answer: "masunurin", Subject: "Filipino", Difficulty: "Hard"},
-	{Text: "Piliin ang tambalang salita.", Choices: []string{"bahaghari", "gabi", "gatas"}, Answer: "bahaghari", Subject: "Filipino", Difficulty: "Hard"},
-	{Text: "Ano ang tawag sa tunog ng aso?", Choices: []string{"meow", "tiktilaok", "aw-aw"}, Answer: "aw-aw", Subject: "Filipino", Difficulty: "Hard"},
-	{Text: "Ano ang dapat gamitin sa katapusan ng tanong?", Choices: []string{"tuldok", "tandang padamdam", "tandang pananong"}, Answer: "tandang pananong", Subject: "Filipino", Difficulty: "Hard"},
-	{Text: "Piliin ang tama: Si Jose ay ______ ng kendi.", Choices: []string{"kumain", "kumakain", "kinain"}, Answer: "kumain", Subject: "Filipino", Difficulty: "Hard"},
-	{Text: "Ano ang sagot sa bugtong: May puno walang bunga, may dahon walang sanga?", Choices: []string{"payong", "mesa", "libro"}, Answer: "payong", Subject: "Filipino", Difficulty: "Hard"},
+	{Text: "Ano ang tamang baybay ng salitang 'maganda'?", Choices: []string{"maganda", "magannda", "magnda"}, Answer: "maganda", Subject: "Filipino", Difficulty: "Hard", Explanation: "Ang tamang baybay ay m-a-g-a-n-d-a."},
+	{Text: "Ano ang kasalungat ng salitang 'malaki'?", Choices: []string{"mataas", "maliit", "mahaba"}, Answer: "maliit", Subject: "Filipino", Difficulty: "Hard", Explanation: "Ang kasalungat ng malaki ay maliit."},
+	{Text: "Pumili ng pangngalan: Si Ana ay kumain ng mangga.", Choices: []string{"kumain", "Ana", "mangga"}, Answer: "Ana", Subject: "Filipino", Difficulty: "Hard", Explanation: "Ang pangngalan ay pangalan ng tao, bagay o lugar. Si Ana ang tao sa pangungusap."},
+	{Text: "Anong bahagi ng katawan ang ginagamit sa pagdinig?", Choices: []string{"mata", "tainga", "ilong"}, Answer: "tainga", Subject: "Filipino", Difficulty: "Hard", Explanation: "Tainga ang ginagamit natin sa pagdinig."},
+	{Text: "Anong tunog ang nagsisimula sa titik 'B'?", Choices: []string{"aso", "bola", "gatas"}, Answer: "bola", Subject: "Filipino", Difficulty: "Hard", Explanation: "Ang bola ay nagsisimula sa tunog ng titik b."},
+	{Text: "Alin ang tamang gamit ng 'ng'?", Choices: []string{"Kumain ng saging.", "Ng umaga ay malamig.", "Ng bahay ay malaki."}, Answer: "Kumain ng saging.", Subject: "Filipino", Difficulty: "Hard", Explanation: "Ginagamit ang ng bago ang pangngalan na layon ng pandiwa: kumain ng saging."},
+	{Text: "Piliin ang salitang may diptonggo.", Choices: []string{"bata", "gabi", "araw"}, Answer: "araw", Subject: "Filipino", Difficulty: "Hard", Explanation: "Ang diptonggo ay patinig na sinusundan ng w o y sa iisang pantig, tulad ng -aw sa araw."},
+	{Text: "Ano ang sagot sa bugtong: Isang balong malalim, punong-puno ng patalim?", Choices: []string{"bibig", "balon", "ilong"}, Answer: "bibig", Subject: "Filipino", Difficulty: "Hard", Explanation: "Ang bibig ang malalim na balon, at ang mga ngipin ang mga patalim."},
+	{Text: "Alin ang salitang pantig na 'ka-la-ba-sa'?", Choices: []string{"kalabasa", "kamatis", "kahel"}, Answer: "kalabasa", Subject: "Filipino", Difficulty: "Hard", Explanation: "Pagdugtungin ang mga pantig: ka-la-ba-sa ay kalabasa."},
+	{Text: "Piliin ang tamang gamit ng 'ang'.", Choices: []string{"Ang bahay ay malaki.", "Bahay ang malaki.", "Malaki ang bahay."}, Answer: "Ang bahay ay malaki.", Subject: "Filipino", Difficulty: "Hard", Explanation: "Sa karaniwang ayos, ang simuno na may ang ay nauuna at sinusundan ng ay: Ang bahay ay malaki."},
+	{Text: "Alin sa mga sumusunod ang pang-uri?", Choices: []string{"kumain", "mataas", "siya"}, Answer: "mataas", Subject: "Filipino", Difficulty: "Hard", Explanation: "Ang pang-uri ay naglalarawan. Ang mataas ay naglalarawan ng taas."},
+	{Text: "Piliin ang tamang baybay: anák, anak, ana'k", Choices: []string{"anak", "anák", "ana'k"}, Answer: "anak", Subject: "Filipino", Difficulty: "Hard", Explanation: "Sa karaniwang pagsulat ay hindi na nilalagyan ng tuldik: anak."},
+	{Text: "Ano ang kasalungat ng 'maingay'?", Choices: []string{"matahimik", "masaya", "malakas"}, Answer: "matahimik", Subject: "Filipino", Difficulty: "Hard", Explanation: "Ang kasalungat ng maingay ay matahimik."},
+	{Text: "Anong bahagi ng pangungusap ang 'umalis si kuya'?", Choices: []string{"simuno", "panaguri", "pangatnig"}, Answer: "panaguri", Subject: "Filipino", Difficulty: "Hard", Explanation: "Ang panaguri ang nagsasabi tungkol sa simuno. Ang umalis ang sinasabi tungkol kay kuya."},
+	{Text: "Alin ang panghalip panao?", Choices: []string{"siya", "bata", "bahay"}, Answer: "siya", Subject: "Filipino", Difficulty: "Hard", Explanation: "Ang panghalip panao ay pamalit sa pangalan ng tao, tulad ng siya."},
+	{Text: "Ano ang kahulugan ng 'matimtiman'?", Choices: []string{"malakas", "matahimik", "masunurin"}, Answer: "masunurin", Subject: "Filipino", Difficulty: "Hard", Explanation: "Ang taong matimtiman ay mahinhin at masunurin."},
+	{Text: "Piliin ang tambalang salita.", Choices: []string{"bahaghari", "gabi", "gatas"}, Answer: "bahaghari", Subject: "Filipino", Difficulty: "Hard", Explanation: "Ang bahaghari ay pinagsamang bahag at hari."},
+	{Text: "Ano ang tawag sa tunog ng aso?", Choices: []string{"meow", "tiktilaok", "aw-aw"}, Answer: "aw-aw", Subject: "Filipino", Difficulty: "Hard", Explanation: "Aw-aw ang tunog ng aso. Ang tiktilaok ay sa manok."},
+	{Text: "Ano ang dapat gamitin sa katapusan ng tanong?", Choices: []string{"tuldok", "tandang padamdam", "tandang pananong"}, Answer: "tandang pananong", Subject: "Filipino", Difficulty: "Hard", Explanation: "Tandang pananong ang inilalagay sa dulo ng tanong."},
+	{Text: "Piliin ang tama: Si Jose ay ______ ng kendi.", Choices: []string{"kumain", "kumakain", "kinain"}, Answer: "kumain", Subject: "Filipino", Difficulty: "Hard", Explanation: "Kumain ang tamang anyo ng pandiwa para sa natapos nang kilos na si Jose ang gumawa."},
+	{Text: "Ano ang sagot sa bugtong: May puno walang bunga, may dahon walang sanga?", Choices: []string{"payong", "mesa", "libro"}, Answer: "payong", Subject: "Filipino", Difficulty: "Hard", Explanation: "Ang payong ay may tangkay na parang puno at telang parang dahon, pero walang bunga o sanga."},
 	// Filipino(Extreme)
-	{Text: "Ano ang kabaligtaran ng 'masaya'?", Choices: []string{"Malungkot", "Masigla", "Masarap"}, Answer: "Malungkot", Subject: "Filipino", Difficulty: "Extreme"},
-	{Text: "Anong tunog ang unang maririnig sa salitang 'kabayo'?", Choices: []string{"Ka", "Ba", "Yo"}, Answer: "Ka", Subject: "Filipino", Difficulty: "Extreme"},
-	{Text: "Ano ang tawag sa larawang gumagamit ng salita?", Choices: []string{"Tula", "Pabula", "Kuwento"}, Answer: "Kuwento", Subject: "Filipino", Difficulty: "Extreme"},
-	{Text: "Piliin ang tamang gamit ng 'ng': Ang bata ___ umiyak ay nawalan ng laruan.", Choices: []string{"na", "ng", "nang"}, Answer: "ng", Subject: "Filipino", Difficulty: "Extreme"},
-	{Text: "Alin ang salitang kilos?", Choices: []string{"Takbo", "Mesa", "Gabi"}, Answer: "Takbo", Subject: "Filipino", Difficulty: "Extreme"},
-	{Text: "Ano ang kasunod ng titik E sa alpabeto?", Choices: []string{"F", "D", "G"}, Answer: "F", Subject: "Filipino", Difficulty: "Extreme"},
-	{Text: "Piliin ang wastong baybay: 'Mga taong nag-aaral'", Choices: []string{"Estudyante", "Estudiyante", "Estudyanti"}, Answer: "Estudyante", Subject: "Filipino", Difficulty: "Extreme"},
-	{Text: "Ano ang ibig sabihin ng 'matimtiman'?", Choices: []string{"Maingay", "Tahimik", "Maayos"}, Answer: "Tahimik", Subject: "Filipino", Difficulty: "Extreme"},
-	{Text: "Alin sa mga ito ang pantig ng salitang 'umaga'?", Choices: []string{"u-ma-ga", "um-a-ga", "uma-ga"}, Answer: "u-ma-ga", Subject: "Filipino", Difficulty: "Extreme"},
-	{Text: "Ang salitang 'maganda' ay kabaligtaran ng?", Choices: []string{"Pangit", "Maayos", "Mabait"}, Answer: "Pangit", Subject: "Filipino", Difficulty: "Extreme"},
-	{Text: "Anong uri ng hayop si 'Pagong' sa kuwentong 'Pagong at Matsing'?", Choices: []string{"Reptilya", "Isda", "Amphibian"}, Answer: "Reptilya", Subject: "Filipino", Difficulty: "Extreme"},
-	{Text: "Ano ang ibig sabihin ng salitang 'masigasig'?", Choices: []string{"Tamad", "Masipag", "Malakas"}, Answer: "Masipag", Subject: "Filipino", Difficulty: "Extreme"},
-	{Text: "Alin ang hindi kabilang: Bola, Aklat, Isda, Lapís?", Choices: []string{"Isda", "Bola", "Lapis"}, Answer: "Isda", Subject: "Filipino", Difficulty: "Extreme"},
-	{Text: "Saan ginagamit ang salitang 'po' at 'opo'?", Choices: []string{"Sa kaibigan", "Sa bata", "Sa nakatatanda"}, Answer: "Sa nakatatanda", Subject: "Filipino", Difficulty: "Extreme"},
-	{Text: "Ang 'Aso ay tumatahol.' Ano ang pandiwa?", Choices: []string{"Aso", "Tumatahol", "Ay"}, Answer: "Tumatahol", Subject: "Filipino", Difficulty: "Extreme"},
-	{Text: "Ang salitang 'bituin' ay tumutukoy sa?", Choices: []string{"Hayop", "Bagay", "Kalangitan"}, Answer: "Kalangitan", Subject: "Filipino", Difficulty: "Extreme"},
-	{Text: "Anong bahagi ng katawan ang ginagamit sa pandinig?", Choices: []string{"Ilong", "Tenga", "Mata"}, Answer: "Tenga", Subject: "Filipino", Difficulty: "Extreme"},
-	{Text: "Ano ang kasingkahulugan ng 'mabilis'?", Choices: []string{"Mabagal", "Matulin", "Malakas"}, Answer: "Matulin", Subject: "Filipino", Difficulty: "Extreme"},
-	{Text: "Ano ang ibig sabihin ng 'panaginip'?", Choices: []string{"Gabi", "Isip", "Nasa isip habang natutulog"}, Answer: "Nasa isip habang natutulog", Subject: "Filipino", Difficulty: "Extreme"},
-	{Text: "Alin sa mga ito ang isang bugtong?", Choices: []string{"Maliit na bahay, puno ng halakhak", "May aso sa labas", "Nagmamadaling bata"}, Answer: "Maliit na bahay, puno ng halakhak", Subject: "Filipino", Difficulty: "Extreme"},
-	{Text: "Ano ang kasingkahulugan ng 'masaya'?", Choices: []string{"Malungkot", "Masigla", "Matapang"}, Answer: "Masigla", Subject: "Filipino", Difficulty: "Extreme"},
-	{Text: "Alin ang tamang gamit ng 'nang': Kumain siya ___ tahimik.", Choices: []string{"ng", "nang", "na"}, Answer: "nang", Subject: "Filipino", Difficulty: "Extreme"},
-	{Text: "Ano ang ibig sabihin ng 'umaga'?", Choices: []string{"Gabi", "Gitna ng araw", "Simula ng araw"}, Answer: "Simula ng araw", Subject: "Filipino", Difficulty: "Extreme"},
-	{Text: "Alin ang wastong sagot: Ako ay may alaga, ___ ay pusa.", Choices: []string{"Siya", "Ito", "Ito'y"}, Answer: "Ito", Subject: "Filipino", Difficulty: "Extreme"},
-	{Text: "Ano ang gamit ng bantas na tandang pananong ( ? )?", Choices: []string{"Sa tanong", "Sa utos", "Sa kuwento"}, Answer: "Sa tanong", Subject: "Filipino", Difficulty: "Extreme"},
-	{Text: "Ano ang salitang inuulit sa 'araw-araw'?", Choices: []string{"Araw", "Raw", "Wala"}, Answer: "Araw", Subject: "Filipino", Difficulty: "Extreme"},
-	{Text: "Anong uri ng pangungusap ito: 'Pakibuksan ang pinto.'?", Choices: []string{"Pautos", "Patanong", "Pasalaysay"}, Answer: "Pautos", Subject: "Filipino", Difficulty: "Extreme"},
+	{Text: "Ano ang kabaligtaran ng 'masaya'?", Choices: []string{"Malungkot", "Masigla", "Masarap"}, Answer: "Malungkot", Subject: "Filipino", Difficulty: "Extreme", Explanation: "Ang kabaligtaran ng masaya ay malungkot."},
+	{Text: "Anong tunog ang unang maririnig sa salitang 'kabayo'?", Choices: []string{"Ka", "Ba", "Yo"}, Answer: "Ka", Subject: "Filipino", Difficulty: "Extreme", Explanation: "Ang kabayo ay ka-ba-yo, kaya ka ang unang pantig."},
+	{Text: "Ano ang tawag sa larawang gumagamit ng salita?", Choices: []string{"Tula", "Pabula", "Kuwento"}, Answer: "Kuwento", Subject: "Filipino", Difficulty: "Extreme", Explanation: "Ang kuwento ay gumagamit ng salita upang ipakita ang mga pangyayari."},
+	{Text: "Piliin ang tamang gamit ng 'ng': Ang bata ___ umiyak ay nawalan ng laruan.", Choices: []string{"na", "ng", "nang"}, Answer: "na", Subject: "Filipino", Difficulty: "Extreme", Explanation: "Ang na ang pang-angkop na nag-uugnay sa bata at umiyak. Ang ng ay bago ang layon, gaya ng nawalan ng laruan."},
+	{Text: "Alin ang salitang kilos?", Choices: []string{"Takbo", "Mesa", "Gabi"}, Answer: "Takbo", Subject: "Filipino", Difficulty: "Extreme", Explanation: "Ang takbo ay kilos. Ang mesa ay bagay at ang gabi ay panahon."},
+	{Text: "Ano ang kasunod ng titik E sa alpabeto?", Choices: []string{"F", "D", "G"}, Answer: "F", Subject: "Filipino", Difficulty: "Extreme", Explanation: "Sa alpabeto: D, E, F. Ang F ang kasunod ng E."},
+	{Text: "Piliin ang wastong baybay: 'Mga taong nag-aaral'", Choices: []string{"Estudyante", "Estudiyante", "Estudyanti"}, Answer: "Estudyante", Subject: "Filipino", Difficulty: "Extreme", Explanation: "Ang wastong baybay ay estudyante."},
+	{Text: "Ano ang ibig sabihin ng 'matimtiman'?", Choices: []string{"Maingay", "Tahimik", "Maayos"}, Answer: "Tahimik", Subject: "Filipino", Difficulty: "Extreme", Explanation: "Ang taong matimtiman ay tahimik at mahinhin."},
+	{Text: "Alin sa mga ito ang pantig ng salitang 'umaga'?", Choices: []string{"u-ma-ga", "um-a-ga", "uma-ga"}, Answer: "u-ma-ga", Subject: "Filipino", Difficulty: "Extreme", Explanation: "Ang umaga ay may tatlong pantig: u-ma-ga."},
+	{Text: "Ang salitang 'maganda' ay kabaligtaran ng?", Choices: []string{"Pangit", "Maayos", "Mabait"}, Answer: "Pangit", Subject: "Filipino", Difficulty: "Extreme", Explanation: "Ang kabaligtaran ng maganda ay pangit."},
+	{Text: "Anong uri ng hayop si 'Pagong' sa kuwentong 'Pagong at Matsing'?", Choices: []string{"Reptilya", "Isda", "Amphibian"}, Answer: "Reptilya", Subject: "Filipino", Difficulty: "Extreme", Explanation: "Ang pagong ay reptilya, tulad ng butiki at ahas."},
+	{Text: "Ano ang ibig sabihin ng salitang 'masigasig'?", Choices: []string{"Tamad", "Masipag", "Malakas"}, Answer: "Masipag", Subject: "Filipino", Difficulty: "Extreme", Explanation: "Ang masigasig ay masipag at hindi sumusuko."},
+	{Text: "Alin ang hindi kabilang: Bola, Aklat, Isda, Lapís?", Choices: []string{"Isda", "Bola", "Lapis"}, Answer: "Isda", Subject: "Filipino", Difficulty: "Extreme", Explanation: "Ang isda ay may buhay. Ang bola, aklat at lapis ay mga bagay."},
+	{Text: "Saan ginagamit ang salitang 'po' at 'opo'?", Choices: []string{"Sa kaibigan", "Sa bata", "Sa nakatatanda"}, Answer: "Sa nakatatanda", Subject: "Filipino", Difficulty: "Extreme", Explanation: "Ginagamit ang po at opo bilang paggalang sa nakatatanda."},
+	{Text: "Ang 'Aso ay tumatahol.' Ano ang pandiwa?", Choices: []string{"Aso", "Tumatahol", "Ay"}, Answer: "Tumatahol", Subject: "Filipino", Difficulty: "Extreme", Explanation: "Ang pandiwa ay salitang kilos. Ang tumatahol ang ginagawa ng aso."},
+	{Text: "Ang salitang 'bituin' ay tumutukoy sa?", Choices: []string{"Hayop", "Bagay", "Kalangitan"}, Answer: "Kalangitan", Subject: "Filipino", Difficulty: "Extreme", Explanation: "Ang mga bituin ay makikita sa kalangitan tuwing gabi."},
+	{Text: "Anong bahagi ng katawan ang ginagamit sa pandinig?", Choices: []string{"Ilong", "Tenga", "Mata"}, Answer: "Tenga", Subject: "Filipino", Difficulty: "Extreme", Explanation: "Tenga ang ginagamit natin sa pandinig."},
+	{Text: "Ano ang kasingkahulugan ng 'mabilis'?", Choices: []string{"Mabagal", "Matulin", "Malakas"}, Answer: "Matulin", Subject: "Filipino", Difficulty: "Extreme", Explanation: "Ang matulin ay kasingkahulugan ng mabilis."},
+	{Text: "Ano ang ibig sabihin ng 'panaginip'?", Choices: []string{"Gabi", "Isip", "Nasa isip habang natutulog"}, Answer: "Nasa isip habang natutulog", Subject: "Filipino", Difficulty: "Extreme", Explanation: "Ang panaginip ay mga nakikita sa isip habang natutulog."},
+	{Text: "Alin sa mga ito ang isang bugtong?", Choices: []string{"Maliit na bahay, puno ng halakhak", "May aso sa labas", "Nagmamadaling bata"}, Answer: "Maliit na bahay, puno ng halakhak", Subject: "Filipino", Difficulty: "Extreme", Explanation: "Ang bugtong ay palaisipang naglalarawan ng bagay nang hindi ito pinangangalanan."},
+	{Text: "Ano ang kasingkahulugan ng 'masaya'?", Choices: []string{"Malungkot", "Masigla", "Matapang"}, Answer: "Masigla", Subject: "Filipino", Difficulty: "Extreme", Explanation: "Ang taong masigla ay masaya at puno ng buhay."},
+	{Text: "Alin ang tamang gamit ng 'nang': Kumain siya ___ tahimik.", Choices: []string{"ng", "nang", "na"}, Answer: "nang", Subject: "Filipino", Difficulty: "Extreme", Explanation: "Ginagamit ang nang kapag sinasabi kung paano ginawa ang kilos: kumain nang tahimik."},
+	{Text: "Ano ang ibig sabihin ng 'umaga'?", Choices: []string{"Gabi", "Gitna ng araw", "Simula ng araw"}, Answer: "Simula ng araw", Subject: "Filipino", Difficulty: "Extreme", Explanation: "Ang umaga ang simula ng araw, pagsikat ng araw."},
+	{Text: "Alin ang wastong sagot: Ako ay may alaga, ___ ay pusa.", Choices: []string{"Siya", "Ito", "Ito'y"}, Answer: "Ito", Subject: "Filipino", Difficulty: "Extreme", Explanation: "Ito ang ginagamit na panghalip para sa hayop o bagay."},
+	{Text: "Ano ang gamit ng bantas na tandang pananong ( ? )?", Choices: []string{"Sa tanong", "Sa utos", "Sa kuwento"}, Answer: "Sa tanong", Subject: "Filipino", Difficulty: "Extreme", Explanation: "Inilalagay ang tandang pananong sa dulo ng tanong."},
+	{Text: "Ano ang salitang inuulit sa 'araw-araw'?", Choices: []string{"Araw", "Raw", "Wala"}, Answer: "Araw", Subject: "Filipino", Difficulty: "Extreme", Explanation: "Inuulit ang salitang araw sa araw-araw."},
+	{Text: "Anong uri ng pangungusap ito: 'Pakibuksan ang pinto.'?", Choices: []string{"Pautos", "Patanong", "Pasalaysay"}, Answer: "Pautos", Subject: "Filipino", Difficulty: "Extreme", Explanation: "Ang pautos ay nag-uutos o nakikiusap na gawin ang isang bagay."},
 }
 
 // NewQuiz creates a new quiz with sample questions
@@ -370,68 +373,50 @@ func (q *Quiz) GetRandomQuestion(subject, difficulty string) *Question {
 	return &filtered[idx]
 }
 
-// CheckAnswer checks if the answer is correct (case-insensitive)
-func (q *Quiz) CheckAnswer(ques *Question, answer string) bool {
-	return strings.EqualFold(strings.TrimSpace(ques.Answer), strings.TrimSpace(answer))
+// SelectQuestions returns up to n shuffled questions of the given difficulty drawn from any of the subjects
+func (q *Quiz) SelectQuestions(subjects []string, difficulty string, n int) []Question {
+	return q.selectQuestions(subjects, difficulty, n, rand.Shuffle)
 }
 
-func InsertUser(name string) (int64, error) {
-	res, err := DB.Exec("INSERT INTO users (name) VALUES (?)", name)
-	if err != nil {
-		return 0, err
-	}
-	return res.LastInsertId()
+// SelectQuestionsSeeded works like SelectQuestions but shuffles with the given seed,
+// so every player using the same seed gets the same questions in the same order
+func (q *Quiz) SelectQuestionsSeeded(subjects []string, difficulty string, n int, seed int64) []Question {
+	return q.selectQuestions(subjects, difficulty, n, rand.New(rand.NewSource(seed)).Shuffle)
 }
 
-func InsertLeaderboard(userID int64, score, quests, boosts int, accuracy, bonus float64) error {
-	_, err := DB.Exec(
-		"INSERT INTO leaderboard (user_id, score, quests_completed, weapon_boosts, accuracy, bonus_success) VALUES (?, ?, ?, ?, ?, ?)",
-		userID, score, quests, boosts, accuracy, bonus,
-	)
-	return err
-}
-
-func GetTopLeaderboard(limit int) ([]LeaderboardEntry, error) {
-	rows, err := DB.Query(
-		`SELECT u.name, l.score, l.quests_completed, l.weapon_boosts, l.accuracy, l.bonus_success
-		 FROM leaderboard l
-		 JOIN users u ON l.user_id = u.id
-		 ORDER BY l.score DESC
-		 LIMIT ?`, limit)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var entries []LeaderboardEntry
-	for rows.Next() {
-		var e LeaderboardEntry
-		if err := rows.Scan(&e.PlayerName, &e.Score, &e.QuestsCompleted, &e.WeaponBoosts, &e.Accuracy, &e.BonusSuccess); err != nil {
-			return nil, err
+func (q *Quiz) selectQuestions(subjects []string, difficulty string, n int, shuffle func(n int, swap func(i, j int))) []Question {
+	var filtered []Question
+	for _, ques := range q.Questions {
+		if !strings.EqualFold(ques.Difficulty, difficulty) {
+			continue
+		}
+		for _, s := range subjects {
+			if strings.EqualFold(ques.Subject, s) {
+				filtered = append(filtered, ques)
+				break
+			}
 		}
-		entries = append(entries, e)
 	}
-	return entries, nil
-}
-
-// Check if user has completed a difficulty for a subject
-func HasCompletedDifficulty(userID int64, subject, difficulty string) (bool, error) {
-	var count int
-	err := DB.QueryRow("SELECT COUNT(*) FROM user_progress WHERE user_id = ? AND subject = ? AND difficulty = ?", userID, subject, difficulty).Scan(&count)
-	if err != nil {
-		return false, err
+	shuffle(len(filtered), func(i, j int) { filtered[i], filtered[j] = filtered[j], filtered[i] })
+	if n >= 0 && len(filtered) > n {
+		filtered = filtered[:n]
 	}
-	return count > 0, nil
+	return filtered
 }
 
-// Mark a difficulty as completed for a user and subject
-func MarkDifficultyCompleted(userID int64, subject, difficulty string) error {
-	_, err := DB.Exec("INSERT INTO user_progress (user_id, subject, difficulty) VALUES (?, ?, ?) ON DUPLICATE KEY UPDATE completed_at = CURRENT_TIMESTAMP", userID, subject, difficulty)
-	return err
+// AnswerIndex is the position of the answer among the choices, -1 if it is missing.
+// It compares exactly: choices may differ only in capitals, like "i like ice cream."
+// and "I like ice cream.".
+func (q Question) AnswerIndex() int {
+	for i, c := range q.Choices {
+		if c == q.Answer {
+			return i
+		}
+	}
+	return -1
 }
 
-// GetTotalScore returns the total score from all subjects for a user
-func GetTotalScore(userID int64) (int, error) {
-	var totalScore int
-	err := DB.QueryRow("SELECT COALESCE(SUM(score), 0) FROM leaderboard WHERE user_id = ?", userID).Scan(&totalScore)
-	return totalScore, err
+// CheckAnswer checks if the answer is correct (case-insensitive)
+func (q *Quiz) CheckAnswer(ques *Question, answer string) bool {
+	return strings.EqualFold(strings.TrimSpace(ques.Answer), strings.TrimSpace(answer))
 }
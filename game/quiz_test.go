@@ -0,0 +1,16 @@
+package game
+
+import "testing"
+
+func TestAnswerIndex(t *testing.T) {
+	for _, q := range NewQuiz().Questions {
+		i := q.AnswerIndex()
+		if i < 0 {
+			t.Errorf("%s %s %q: answer %q is not a choice", q.Subject, q.Difficulty, q.Text, q.Answer)
+			continue
+		}
+		if q.Text == "Which sentence uses capital letters correctly?" && i != 2 {
+			t.Errorf("%q: answer index %d, want 2, the choice with the same capitals", q.Text, i)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package game
+
+import "math"
+
+// Skill ratings: an Elo rating per player and subject, updated after every battle.
+// In solo play a battle is one game per subject, scored by the accuracy against
+// the mean difficulty of its questions; in versus the other captain is the
+// opponent. A long battle moves a rating no more than a short one. Unlike raw
+// scores, grinding hard battles does not beat playing easy ones well.
+const (
+	RatingStart = 1200.0
+	// New ratings move fast until they have seen enough battles
+	ratingKProvisional = 32.0
+	ratingKSettled     = 16.0
+	ratingProvisional  = 10
+	// RatingMixed is the subject of battles that mix subjects, like versus
+	RatingMixed = "Mixed"
+	// Ratings need this many battles before they make the leaderboard
+	RatingMinGames = 3
+)
+
+// DifficultyRatings is how strong a question of each difficulty plays as an opponent
+var DifficultyRatings = map[string]float64{"Easy": 1000, "Medium": 1200, "Hard": 1400, "Extreme": 1600}
+
+// Rating is a player's skill in one subject
+type Rating struct {
+	Subject string
+	Rating  float64
+	Games   int // battles rated
+}
+
+// RatingEntry is a row of the rating leaderboard
+type RatingEntry struct {
+	PlayerName string
+	Subject    string
+	Rating     int
+	Games      int
+}
+
+// Provisional reports whether the rating has too few games to be settled
+func (r Rating) Provisional() bool {
+	return r.Games < ratingProvisional
+}
+
+// ExpectedScore is the chance a player rated r beats an opponent rated opp
+func ExpectedScore(r, opp float64) float64 {
+	return 1 / (1 + math.Pow(10, (opp-r)/400))
+}
+
+// play applies one game against opp and counts it; score is 1 for a win, 0.5 a
+// draw, 0 a loss, or the share of a battle's answers that were right.
+func (r Rating) play(opp, score float64) Rating {
+	k := ratingKSettled
+	if r.Provisional() {
+		k = ratingKProvisional
+	}
+	r.Rating += k * (score - ExpectedScore(r.Rating, opp))
+	r.Games++
+	return r
+}
+
+// difficultyRating is the rating a question of the difficulty plays at; unknown
+// difficulties play as medium
+func difficultyRating(difficulty string) float64 {
+	if opp, ok := DifficultyRatings[difficulty]; ok {
+		return opp
+	}
+	return DifficultyRatings["Medium"]
+}
+
+// RateBattle updates a rating with a battle's answers in its subject, as one game
+// scored by their accuracy against the mean rating of their questions
+func RateBattle(r Rating, records []AnswerRecord) Rating {
+	if len(records) == 0 {
+		return r
+	}
+	var opp, correct float64
+	for _, rec := range records {
+		opp += difficultyRating(rec.Difficulty)
+		if rec.Correct {
+			correct++
+		}
+	}
+	n := float64(len(records))
+	return r.play(opp/n, correct/n)
+}
+
+// RateMatch updates both ratings after a match; winner is 0 or 1, -1 for a draw.
+// Both sides are scored against the other's rating from before the match.
+func RateMatch(a, b Rating, winner int) (Rating, Rating) {
+	score := 0.5
+	switch winner {
+	case 0:
+		score = 1
+	case 1:
+		score = 0
+	}
+	return a.play(b.Rating, score), b.play(a.Rating, 1-score)
+}
+
+// UpdateRatings rates a battle once in every subject it had answers in, with
+// RateBattle. Answers without a subject count towards fallback.
+func UpdateRatings(s Store, userID int64, fallback string, records []AnswerRecord) error {
+	bySubject := map[string][]AnswerRecord{}
+	var order []string
+	for _, rec := range records {
+		subject := rec.Subject
+		if subject == "" {
+			subject = fallback
+		}
+		if _, ok := bySubject[subject]; !ok {
+			order = append(order, subject)
+		}
+		bySubject[subject] = append(bySubject[subject], rec)
+	}
+	for _, subject := range order {
+		r, err := s.GetRating(userID, subject)
+		if err != nil {
+			return err
+		}
+		if err := s.SaveRating(userID, RateBattle(r, bySubject[subject])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpdateMatchRatings rates a finished versus match between two saved players
+func UpdateMatchRatings(s Store, m *VersusMatch) error {
+	a, err := s.GetRating(m.Players[0].UserID, RatingMixed)
+	if err != nil {
+		return err
+	}
+	b, err := s.GetRating(m.Players[1].UserID, RatingMixed)
+	if err != nil {
+		return err
+	}
+	a, b = RateMatch(a, b, m.Winner())
+	if err := s.SaveRating(m.Players[0].UserID, a); err != nil {
+		return err
+	}
+	return s.SaveRating(m.Players[1].UserID, b)
+}
+
+// roundRating is the whole-number rating shown to players
+func roundRating(r float64) int {
+	return int(math.Round(r))
+}
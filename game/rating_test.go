@@ -0,0 +1,59 @@
+package game
+
+import (
+	"math"
+	"testing"
+)
+
+// answers makes n answers in a subject and difficulty, the first right of them correct
+func answers(subject, difficulty string, n, right int) []AnswerRecord {
+	records := make([]AnswerRecord, n)
+	for i := range records {
+		records[i] = AnswerRecord{Subject: subject, Difficulty: difficulty, Correct: i < right}
+	}
+	return records
+}
+
+func TestUpdateRatings(t *testing.T) {
+	cases := []struct {
+		name    string
+		records []AnswerRecord
+		want    map[string]Rating
+	}{
+		// A battle is one game whatever its length: a perfect battle against
+		// even questions gains half of K
+		{"short perfect battle", answers("Math", "Medium", 5, 5), map[string]Rating{"Math": {"Math", 1216, 1}}},
+		{"long perfect battle", answers("Math", "Medium", 20, 20), map[string]Rating{"Math": {"Math", 1216, 1}}},
+		{"half right", answers("Math", "Medium", 10, 5), map[string]Rating{"Math": {"Math", 1200, 1}}},
+		{"weaker questions", answers("Math", "Easy", 10, 10), map[string]Rating{"Math": {"Math", 1200 + 32*(1-ExpectedScore(1200, 1000)), 1}}},
+		// Easy and Hard average out to Medium
+		{"mixed difficulties", append(answers("Math", "Easy", 4, 4), answers("Math", "Hard", 4, 4)...), map[string]Rating{"Math": {"Math", 1216, 1}}},
+		{"two subjects", append(answers("Math", "Medium", 6, 0), answers("", "Medium", 2, 2)...),
+			map[string]Rating{"Math": {"Math", 1184, 1}, "English": {"English", 1216, 1}}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			s := NewMemoryStore()
+			ana, err := s.InsertUser("Ana")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := UpdateRatings(s, ana, "English", c.records); err != nil {
+				t.Fatal(err)
+			}
+			got, err := s.GetRatings(ana)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(got) != len(c.want) {
+				t.Fatalf("ratings = %+v, want %+v", got, c.want)
+			}
+			for _, r := range got {
+				want := c.want[r.Subject]
+				if math.Abs(r.Rating-want.Rating) > 1e-9 || r.Games != want.Games {
+					t.Errorf("%s rating = %+v, want %+v", r.Subject, r, want)
+				}
+			}
+		})
+	}
+}
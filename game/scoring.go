@@ -0,0 +1,114 @@
+package game
+
+import (
+	"math"
+	"strings"
+	"time"
+)
+
+// SpeedCurve describes how the speed bonus falls off as the question timer runs down.
+type SpeedCurve struct {
+	Grace    time.Duration // answers at or under this time earn the full bonus
+	MaxBonus float64       // full bonus as a fraction of the level's base points
+	Exponent float64       // 1 = linear falloff, higher values favour only very fast answers
+}
+
+// SpeedBonusCurve is the curve used by ProcessAnswer. Replace it to tune speed scoring.
+var SpeedBonusCurve = SpeedCurve{
+	Grace:    time.Second,
+	MaxBonus: 0.5,
+	Exponent: 1.5,
+}
+
+// Bonus returns the speed bonus points for a correct answer given after elapsed,
+// out of a question time limit of limit.
+func (c SpeedCurve) Bonus(level int, elapsed, limit time.Duration) int {
+	if c.MaxBonus <= 0 || limit <= 0 || elapsed >= limit {
+		return 0
+	}
+	frac := 1.0
+	if elapsed > c.Grace && limit > c.Grace {
+		frac = 1.0 - float64(elapsed-c.Grace)/float64(limit-c.Grace)
+	}
+	if c.Exponent > 0 && c.Exponent != 1 {
+		frac = math.Pow(frac, c.Exponent)
+	}
+	return int(math.Round(float64(basePoints[level]) * c.MaxBonus * frac))
+}
+
+// Combo tuning: every consecutive correct answer after the first adds ComboStep
+// to the damage and score multiplier, up to ComboCap.
+var (
+	ComboStep = 0.25
+	ComboCap  = 2.0
+)
+
+// ComboMultiplier returns the damage and score multiplier for a streak of correct answers.
+func ComboMultiplier(streak int) float64 {
+	if streak <= 1 {
+		return 1.0
+	}
+	m := 1.0 + ComboStep*float64(streak-1)
+	if m > ComboCap {
+		m = ComboCap
+	}
+	return m
+}
+
+// HintCostFraction is the part of a question's base points deducted when its hint is revealed
+var HintCostFraction = 0.5
+
+// HintCost returns the points deducted for revealing a hint at the given level
+func HintCost(level int) int {
+	return int(math.Round(float64(basePoints[level]) * HintCostFraction))
+}
+
+// AnswerRecord captures how a single question was answered, for analytics.
+type AnswerRecord struct {
+	QuestionID   string
+	Question     string
+	Chosen       string // the option picked, "" if time ran out
+	Correct      bool
+	TimedOut     bool
+	ResponseTime time.Duration
+	SpeedBonus   int
+	PowerUps     []PowerUp // aids used on this question
+	HintUsed     bool
+	// Subject and Difficulty of the question, for the skill ratings
+	Subject    string
+	Difficulty string
+}
+
+// powerUpList names the aids used on the question, comma separated
+func (r AnswerRecord) powerUpList() string {
+	aids := make([]string, len(r.PowerUps))
+	for i, p := range r.PowerUps {
+		aids[i] = p.String()
+	}
+	return strings.Join(aids, ",")
+}
+
+// parsePowerUpList reads back a list written by powerUpList
+func parsePowerUpList(list string) []PowerUp {
+	var aids []PowerUp
+	for _, name := range strings.Split(list, ",") {
+		for p := PowerUp(0); p < NumPowerUps; p++ {
+			if p.String() == name {
+				aids = append(aids, p)
+			}
+		}
+	}
+	return aids
+}
+
+// AverageResponseTime returns the mean response time over the given answers.
+func AverageResponseTime(records []AnswerRecord) time.Duration {
+	if len(records) == 0 {
+		return 0
+	}
+	var total time.Duration
+	for _, r := range records {
+		total += r.ResponseTime
+	}
+	return total / time.Duration(len(records))
+}
@@ -0,0 +1,749 @@
+package game
+
+import (
+	"database/sql"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	_ "modernc.org/sqlite"
+)
+
+// sqlDialect holds the bits of SQL that MySQL and SQLite spell differently
+type sqlDialect struct {
+	// upsert is the clause that turns an INSERT into an update of the row with the same key
+	upsert func(key ...string) string
+	// inserted refers to the value an upsert tried to insert into a column
+	inserted func(col string) string
+	greatest string
+	// dateText formats a DATE column as YYYY-MM-DD
+	dateText func(col string) string
+	// nameList joins names in the order of another column, comma separated
+	nameList func(col, order string) string
+	// migrations is the directory under migrations/ with the dialect's schema
+	migrations string
+	// hasColumn and hasIndex count the columns or indexes of a table with a name
+	hasColumn string
+	hasIndex  string
+}
+
+var mysqlDialect = sqlDialect{
+	migrations: "mysql",
+	hasColumn:  "SELECT COUNT(*) FROM information_schema.COLUMNS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ? AND COLUMN_NAME = ?",
+	hasIndex:   "SELECT COUNT(*) FROM information_schema.STATISTICS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ? AND INDEX_NAME = ?",
+	upsert:     func(key ...string) string { return "ON DUPLICATE KEY UPDATE" },
+	inserted:   func(col string) string { return "VALUES(" + col + ")" },
+	greatest:   "GREATEST",
+	dateText:   func(col string) string { return "DATE_FORMAT(" + col + ", '%Y-%m-%d')" },
+	nameList: func(col, order string) string {
+		return "GROUP_CONCAT(" + col + " ORDER BY " + order + " SEPARATOR ', ')"
+	},
+}
+
+var sqliteDialect = sqlDialect{
+	migrations: "sqlite",
+	hasColumn:  "SELECT COUNT(*) FROM pragma_table_info(?) WHERE name = ?",
+	hasIndex:   "SELECT COUNT(*) FROM sqlite_master WHERE type = 'index' AND tbl_name = ? AND name = ?",
+	upsert:     func(key ...string) string { return "ON CONFLICT (" + strings.Join(key, ", ") + ") DO UPDATE SET" },
+	inserted:   func(col string) string { return "excluded." + col },
+	greatest:   "MAX",
+	dateText:   func(col string) string { return col },
+	nameList:   func(col, order string) string { return "GROUP_CONCAT(" + col + ", ', ' ORDER BY " + order + ")" },
+}
+
+// sqlStore is the Store on a SQL database
+type sqlStore struct {
+	db *sql.DB
+	d  sqlDialect
+}
+
+func openSQLStore(driver, dsn string, d sqlDialect) (*sqlStore, error) {
+	if driver == "mysql" {
+		var err error
+		if dsn, err = mysqlDSN(dsn); err != nil {
+			return nil, err
+		}
+	}
+	db, err := sql.Open(driver, dsn)
+	if err != nil {
+		return nil, err
+	}
+	if driver == "sqlite" {
+		// One connection avoids "database is locked"
+		db.SetMaxOpenConns(1)
+	}
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &sqlStore{db: db, d: d}, nil
+}
+
+// mysqlDSN sets the session time zone to UTC unless the DSN picks one, so TIMESTAMP
+// columns read and compare in UTC like the times the store passes in
+func mysqlDSN(dsn string) (string, error) {
+	cfg, err := mysql.ParseDSN(dsn)
+	if err != nil {
+		return "", err
+	}
+	if _, ok := cfg.Params["time_zone"]; !ok {
+		if cfg.Params == nil {
+			cfg.Params = make(map[string]string)
+		}
+		cfg.Params["time_zone"] = "'+00:00'"
+	}
+	return cfg.FormatDSN(), nil
+}
+
+func (s *sqlStore) Close() error {
+	return s.db.Close()
+}
+
+func (s *sqlStore) InsertUser(name string) (int64, error) {
+	res, err := s.db.Exec("INSERT INTO users (name) VALUES (?)", name)
+	if err != nil {
+		return 0, err
+	}
+	return res.LastInsertId()
+}
+
+func (s *sqlStore) FindUsers(name string) ([]Account, error) {
+	rows, err := s.db.Query(
+		`SELECT u.id, u.name, u.pin_hash, COUNT(l.id), COALESCE(SUM(l.score), 0)
+		 FROM users u
+		 LEFT JOIN leaderboard l ON l.user_id = u.id
+		 WHERE LOWER(u.name) = LOWER(?)
+		 GROUP BY u.id, u.name, u.pin_hash
+		 ORDER BY COUNT(l.id) DESC, u.id`, strings.TrimSpace(name))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var accounts []Account
+	for rows.Next() {
+		var a Account
+		if err := rows.Scan(&a.ID, &a.Name, &a.PINHash, &a.Battles, &a.Score); err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, a)
+	}
+	return accounts, rows.Err()
+}
+
+func (s *sqlStore) SetUserPIN(userID int64, hash string) error {
+	_, err := s.db.Exec("UPDATE users SET pin_hash = ? WHERE id = ?", hash, userID)
+	return err
+}
+
+func (s *sqlStore) InsertLeaderboard(userID int64, e LeaderboardEntry) error {
+	_, err := s.db.Exec(
+		"INSERT INTO leaderboard (user_id, mode, subject, difficulty, score, quests_completed, weapon_boosts, accuracy, bonus_success, longest_streak, powerups_used) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		userID, string(e.Mode), e.Subject, e.Difficulty, e.Score, e.QuestsCompleted, e.WeaponBoosts, e.Accuracy, e.BonusSuccess, e.LongestStreak, e.PowerUpsUsed,
+	)
+	return err
+}
+
+// leaderboardFrom is the FROM and WHERE of the leaderboard rows a query matches,
+// with their arguments
+func leaderboardFrom(q LeaderboardQuery) (string, []any) {
+	since := q.since()
+	return `FROM leaderboard l
+		 JOIN users u ON l.user_id = u.id
+		 WHERE (? = '' OR l.subject = ?) AND (? = '' OR l.difficulty = ?) AND (? = '' OR l.created_at >= ?)
+		   AND (? = 0 OR l.user_id = ? OR LOWER(u.name) IN (
+		        SELECT LOWER(st.name) FROM class_session_students st
+		        JOIN class_sessions c ON c.id = st.session_id
+		        WHERE c.host_id = ?))`,
+		[]any{q.Subject, q.Subject, q.Difficulty, q.Difficulty, since, since, q.ClassHost, q.ClassHost, q.ClassHost}
+}
+
+// leaderboardColumns selects a leaderboard row's id and entry
+const leaderboardColumns = `SELECT l.id, l.user_id, u.name, l.score, l.quests_completed, l.weapon_boosts, l.accuracy, l.bonus_success,
+		        COALESCE(l.longest_streak, 0), l.powerups_used, l.mode, l.subject, l.difficulty `
+
+// scanLeaderboardEntry reads a row selected with leaderboardColumns
+func scanLeaderboardEntry(row interface{ Scan(...any) error }) (id int64, e LeaderboardEntry, err error) {
+	var mode string
+	err = row.Scan(&id, &e.UserID, &e.PlayerName, &e.Score, &e.QuestsCompleted, &e.WeaponBoosts, &e.Accuracy, &e.BonusSuccess,
+		&e.LongestStreak, &e.PowerUpsUsed, &mode, &e.Subject, &e.Difficulty)
+	e.Mode = Mode(mode)
+	return id, e, err
+}
+
+func (s *sqlStore) GetLeaderboard(q LeaderboardQuery) (LeaderboardPage, error) {
+	page := LeaderboardPage{Offset: q.Offset}
+	from, args := leaderboardFrom(q)
+	if err := s.db.QueryRow("SELECT COUNT(*) "+from, args...).Scan(&page.Total); err != nil {
+		return page, err
+	}
+	rows, err := s.db.Query(leaderboardColumns+from+" ORDER BY l.score DESC, l.id LIMIT ? OFFSET ?", append(args, q.Limit, q.Offset)...)
+	if err != nil {
+		return page, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		_, e, err := scanLeaderboardEntry(rows)
+		if err != nil {
+			return page, err
+		}
+		page.Entries = append(page.Entries, e)
+	}
+	return page, rows.Err()
+}
+
+func (s *sqlStore) GetLeaderboardRank(q LeaderboardQuery, userID int64) (int, LeaderboardEntry, error) {
+	from, args := leaderboardFrom(q)
+	id, best, err := scanLeaderboardEntry(s.db.QueryRow(leaderboardColumns+from+" AND l.user_id = ? ORDER BY l.score DESC, l.id LIMIT 1", append(args, userID)...))
+	if err == sql.ErrNoRows {
+		return 0, best, nil
+	}
+	if err != nil {
+		return 0, best, err
+	}
+	var ahead int
+	err = s.db.QueryRow("SELECT COUNT(*) "+from+" AND (l.score > ? OR (l.score = ? AND l.id < ?))", append(args, best.Score, best.Score, id)...).Scan(&ahead)
+	return ahead + 1, best, err
+}
+
+func (s *sqlStore) GetPersonalBests(userID int64) ([]PersonalBest, error) {
+	rows, err := s.db.Query(
+		`SELECT mode, subject, difficulty, MAX(score), COUNT(*)
+		 FROM leaderboard
+		 WHERE user_id = ?
+		 GROUP BY mode, subject, difficulty
+		 ORDER BY MAX(score) DESC, mode, subject, difficulty`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var bests []PersonalBest
+	for rows.Next() {
+		var b PersonalBest
+		var mode string
+		if err := rows.Scan(&mode, &b.Subject, &b.Difficulty, &b.Score, &b.Battles); err != nil {
+			return nil, err
+		}
+		b.Mode = Mode(mode)
+		bests = append(bests, b)
+	}
+	return bests, rows.Err()
+}
+
+func (s *sqlStore) ClassHost(userID int64, name string) (int64, error) {
+	var hosted int
+	if err := s.db.QueryRow("SELECT COUNT(*) FROM class_sessions WHERE host_id = ?", userID).Scan(&hosted); err != nil {
+		return 0, err
+	}
+	if hosted > 0 {
+		return userID, nil
+	}
+	var host int64
+	err := s.db.QueryRow(
+		`SELECT c.host_id
+		 FROM class_sessions c
+		 JOIN class_session_students st ON st.session_id = c.id
+		 WHERE LOWER(st.name) = LOWER(?) AND c.host_id IS NOT NULL
+		 ORDER BY c.id DESC
+		 LIMIT 1`, strings.TrimSpace(name)).Scan(&host)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	return host, err
+}
+
+func (s *sqlStore) GetTotalScore(userID int64) (int, error) {
+	var totalScore int
+	err := s.db.QueryRow("SELECT COALESCE(SUM(score), 0) FROM leaderboard WHERE user_id = ?", userID).Scan(&totalScore)
+	return totalScore, err
+}
+
+func (s *sqlStore) InsertAttempts(b Battle, records []AnswerRecord) (int64, error) {
+	if len(records) == 0 {
+		return 0, nil
+	}
+	tx, err := s.db.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+	res, err := tx.Exec("INSERT INTO battles (user_id, mode, subject, difficulty) VALUES (?, ?, ?, ?)", b.UserID, string(b.Mode), b.Subject, b.Difficulty)
+	if err != nil {
+		return 0, err
+	}
+	battleID, err := res.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+	stmt, err := tx.Prepare(
+		`INSERT INTO attempts (battle_id, user_id, question_id, subject, difficulty, question, chosen, correct, timed_out, response_ms, speed_bonus, hint_used, powerups)
+		 VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`)
+	if err != nil {
+		return 0, err
+	}
+	defer stmt.Close()
+	for _, r := range records {
+		r = b.fill(r)
+		if _, err := stmt.Exec(battleID, b.UserID, r.QuestionID, r.Subject, r.Difficulty, r.Question, r.Chosen, r.Correct, r.TimedOut,
+			r.ResponseTime.Milliseconds(), r.SpeedBonus, r.HintUsed, r.powerUpList()); err != nil {
+			return 0, err
+		}
+	}
+	return battleID, tx.Commit()
+}
+
+const attemptColumns = "COALESCE(battle_id, 0), user_id, question_id, subject, difficulty, question, chosen, correct, timed_out, response_ms, speed_bonus, hint_used, powerups"
+
+func (s *sqlStore) queryAttempts(query string, args ...any) ([]Attempt, error) {
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var attempts []Attempt
+	for rows.Next() {
+		var a Attempt
+		var ms int64
+		var aids string
+		if err := rows.Scan(&a.BattleID, &a.UserID, &a.QuestionID, &a.Subject, &a.Difficulty, &a.Question, &a.Chosen, &a.Correct, &a.TimedOut,
+			&ms, &a.SpeedBonus, &a.HintUsed, &aids); err != nil {
+			return nil, err
+		}
+		a.ResponseTime = time.Duration(ms) * time.Millisecond
+		a.PowerUps = parsePowerUpList(aids)
+		attempts = append(attempts, a)
+	}
+	return attempts, rows.Err()
+}
+
+func (s *sqlStore) GetBattleAttempts(battleID int64) ([]Attempt, error) {
+	return s.queryAttempts("SELECT "+attemptColumns+" FROM attempts WHERE battle_id = ? ORDER BY id", battleID)
+}
+
+func (s *sqlStore) GetUserAttempts(userID int64, limit int) ([]Attempt, error) {
+	return s.queryAttempts("SELECT "+attemptColumns+" FROM attempts WHERE user_id = ? ORDER BY id DESC LIMIT ?", userID, limit)
+}
+
+func (s *sqlStore) GetQuestionStats(subject, difficulty string) ([]QuestionStats, error) {
+	rows, err := s.db.Query(
+		`SELECT MAX(question_id), subject, question, COUNT(*), SUM(correct), SUM(timed_out), SUM(hint_used), AVG(response_ms)
+		 FROM attempts
+		 WHERE (? = '' OR subject = ?) AND (? = '' OR difficulty = ?)
+		 GROUP BY subject, question
+		 ORDER BY AVG(correct), COUNT(*) DESC, question`, subject, subject, difficulty, difficulty)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var stats []QuestionStats
+	for rows.Next() {
+		var q QuestionStats
+		var avgMs float64
+		if err := rows.Scan(&q.QuestionID, &q.Subject, &q.Question, &q.Attempts, &q.Correct, &q.TimedOut, &q.HintsUsed, &avgMs); err != nil {
+			return nil, err
+		}
+		q.AvgResponse = time.Duration(avgMs) * time.Millisecond
+		stats = append(stats, q)
+	}
+	return stats, rows.Err()
+}
+
+func (s *sqlStore) HasCompletedDifficulty(userID int64, subject, difficulty string) (bool, error) {
+	var count int
+	err := s.db.QueryRow("SELECT COUNT(*) FROM user_progress WHERE user_id = ? AND subject = ? AND difficulty = ?", userID, subject, difficulty).Scan(&count)
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (s *sqlStore) MarkDifficultyCompleted(userID int64, subject, difficulty string, stars float64) error {
+	_, err := s.db.Exec(
+		"INSERT INTO user_progress (user_id, subject, difficulty, best_stars) VALUES (?, ?, ?, ?) "+s.d.upsert("user_id", "subject", "difficulty")+
+			" best_stars = "+s.d.greatest+"(best_stars, "+s.d.inserted("best_stars")+"), completed_at = CURRENT_TIMESTAMP",
+		userID, subject, difficulty, stars,
+	)
+	return err
+}
+
+func (s *sqlStore) GetCompletions(userID int64) ([]Completion, error) {
+	rows, err := s.db.Query("SELECT subject, difficulty, best_stars FROM user_progress WHERE user_id = ?", userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var completions []Completion
+	for rows.Next() {
+		var c Completion
+		if err := rows.Scan(&c.Subject, &c.Difficulty, &c.Stars); err != nil {
+			return nil, err
+		}
+		completions = append(completions, c)
+	}
+	return completions, rows.Err()
+}
+
+func (s *sqlStore) RecordBossVictory(userID int64, difficulty string, score int) error {
+	_, err := s.db.Exec(
+		"INSERT INTO boss_victories (user_id, difficulty, score) VALUES (?, ?, ?) "+s.d.upsert("user_id", "difficulty")+
+			" score = "+s.d.greatest+"(score, "+s.d.inserted("score")+"), defeated_at = CURRENT_TIMESTAMP",
+		userID, difficulty, score,
+	)
+	return err
+}
+
+func (s *sqlStore) HasDefeatedBoss(userID int64, difficulty string) (bool, error) {
+	var score int
+	err := s.db.QueryRow("SELECT score FROM boss_victories WHERE user_id = ? AND difficulty = ?", userID, difficulty).Scan(&score)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (s *sqlStore) SaveCampaignProgress(userID int64, r *CampaignRun) error {
+	var done []string
+	for id, ok := range r.Completed {
+		if ok {
+			done = append(done, id)
+		}
+	}
+	sort.Strings(done)
+	in := s.d.inserted
+	_, err := s.db.Exec(
+		"INSERT INTO campaign_progress (user_id, campaign_id, current_node, hp, shields, completed) VALUES (?, ?, ?, ?, ?, ?) "+
+			s.d.upsert("user_id", "campaign_id")+" current_node = "+in("current_node")+", hp = "+in("hp")+", shields = "+in("shields")+
+			", completed = "+in("completed")+", updated_at = CURRENT_TIMESTAMP",
+		userID, r.CampaignID, r.Current, r.HP, r.Shields, strings.Join(done, ","),
+	)
+	return err
+}
+
+func (s *sqlStore) LoadCampaignProgress(userID int64, campaignID string) (*CampaignRun, error) {
+	var current, done string
+	var hp, shields int
+	err := s.db.QueryRow(
+		"SELECT current_node, hp, shields, completed FROM campaign_progress WHERE user_id = ? AND campaign_id = ?",
+		userID, campaignID,
+	).Scan(&current, &hp, &shields, &done)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return loadedCampaignRun(campaignID, current, hp, shields, done), nil
+}
+
+func (s *sqlStore) SavePracticeSession(userID int64, p *PracticeSession) error {
+	_, err := s.db.Exec(
+		"INSERT INTO practice_sessions (user_id, subject, difficulty, correct, incorrect, skipped) VALUES (?, ?, ?, ?, ?, ?)",
+		userID, p.Subject, p.Difficulty, p.Correct, p.Incorrect, p.Skipped,
+	)
+	return err
+}
+
+func (s *sqlStore) StartDailyAttempt(userID int64, c DailyChallenge) error {
+	played, err := s.HasPlayedDaily(userID, c.Date)
+	if err != nil {
+		return err
+	}
+	if played {
+		return ErrDailyPlayed
+	}
+	_, err = s.db.Exec(
+		"INSERT INTO daily_challenge (user_id, challenge_date, bank_version, score, longest_streak) VALUES (?, ?, ?, 0, 0)",
+		userID, c.Date, c.BankVersion,
+	)
+	return err
+}
+
+func (s *sqlStore) SaveDailyResult(userID int64, date string, score, longestStreak int) error {
+	_, err := s.db.Exec(
+		"UPDATE daily_challenge SET score = ?, longest_streak = ? WHERE user_id = ? AND challenge_date = ?",
+		score, longestStreak, userID, date,
+	)
+	return err
+}
+
+func (s *sqlStore) HasPlayedDaily(userID int64, date string) (bool, error) {
+	var score int
+	err := s.db.QueryRow("SELECT score FROM daily_challenge WHERE user_id = ? AND challenge_date = ?", userID, date).Scan(&score)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (s *sqlStore) GetDailyLeaderboard(date string, limit int) ([]LeaderboardEntry, error) {
+	rows, err := s.db.Query(
+		`SELECT u.name, d.score, d.longest_streak
+		 FROM daily_challenge d
+		 JOIN users u ON d.user_id = u.id
+		 WHERE d.challenge_date = ?
+		 ORDER BY d.score DESC, d.id
+		 LIMIT ?`, date, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var entries []LeaderboardEntry
+	for rows.Next() {
+		var e LeaderboardEntry
+		if err := rows.Scan(&e.PlayerName, &e.Score, &e.LongestStreak); err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+	return entries, rows.Err()
+}
+
+func (s *sqlStore) GetDailyHistory(userID int64) ([]DailyResult, error) {
+	rows, err := s.db.Query(
+		`SELECT `+s.d.dateText("challenge_date")+`, score, longest_streak
+		 FROM daily_challenge
+		 WHERE user_id = ?
+		 ORDER BY challenge_date`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var history []DailyResult
+	for rows.Next() {
+		var r DailyResult
+		if err := rows.Scan(&r.Date, &r.Score, &r.LongestStreak); err != nil {
+			return nil, err
+		}
+		history = append(history, r)
+	}
+	return history, rows.Err()
+}
+
+func (s *sqlStore) InsertSurvivalRun(userID int64, score, waves int, subjects string) error {
+	_, err := s.db.Exec(
+		"INSERT INTO survival_leaderboard (user_id, score, waves, subjects) VALUES (?, ?, ?, ?)",
+		userID, score, waves, subjects,
+	)
+	return err
+}
+
+func (s *sqlStore) GetTopSurvival(limit int) ([]SurvivalEntry, error) {
+	rows, err := s.db.Query(
+		`SELECT u.name, s.score, s.waves
+		 FROM survival_leaderboard s
+		 JOIN users u ON s.user_id = u.id
+		 ORDER BY s.score DESC, s.waves DESC, s.id
+		 LIMIT ?`, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var entries []SurvivalEntry
+	for rows.Next() {
+		var e SurvivalEntry
+		if err := rows.Scan(&e.PlayerName, &e.Score, &e.Waves); err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+	return entries, rows.Err()
+}
+
+func (s *sqlStore) InsertTimeAttack(userID int64, r *TimeAttackRun) error {
+	_, err := s.db.Exec(
+		"INSERT INTO timeattack_leaderboard (user_id, difficulty, score, correct, wrong) VALUES (?, ?, ?, ?, ?)",
+		userID, r.Difficulty, r.Score(), r.Correct, r.Wrong,
+	)
+	return err
+}
+
+func (s *sqlStore) GetTopTimeAttack(limit int) ([]TimeAttackEntry, error) {
+	rows, err := s.db.Query(
+		`SELECT u.name, t.score, t.correct
+		 FROM timeattack_leaderboard t
+		 JOIN users u ON t.user_id = u.id
+		 ORDER BY t.score DESC, t.correct DESC, t.id
+		 LIMIT ?`, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var entries []TimeAttackEntry
+	for rows.Next() {
+		var e TimeAttackEntry
+		if err := rows.Scan(&e.PlayerName, &e.Score, &e.Correct); err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+	return entries, rows.Err()
+}
+
+func (s *sqlStore) InsertVersusMatch(m *VersusMatch, difficulty string) error {
+	var winner interface{}
+	if w := m.Winner(); w >= 0 && m.Players[w].UserID > 0 {
+		winner = m.Players[w].UserID
+	}
+	a, b := m.Players[0], m.Players[1]
+	_, err := s.db.Exec(
+		`INSERT INTO versus_matches (player1_id, player2_id, difficulty, player1_score, player2_score, player1_hp, player2_hp, winner_id)
+		 VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		a.UserID, b.UserID, difficulty, a.Score, b.Score, a.HP, b.HP, winner,
+	)
+	return err
+}
+
+func (s *sqlStore) InsertFleetResult(f *Fleet, difficulty string) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	res, err := tx.Exec(
+		"INSERT INTO fleet_results (difficulty, ships, team_score, enemy_sunk, enemy_hp) VALUES (?, ?, ?, ?, ?)",
+		difficulty, len(f.Ships), f.TeamScore(), f.Victory(), f.EnemyHP,
+	)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	resultID, err := res.LastInsertId()
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	stmt, err := tx.Prepare("INSERT INTO fleet_members (result_id, user_id, subject, score, correct, answered, damage, hp) VALUES (?, ?, ?, ?, ?, ?, ?, ?)")
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	defer stmt.Close()
+	for _, sh := range f.Ships {
+		if _, err := stmt.Exec(resultID, sh.UserID, sh.Subject, sh.Score, sh.Correct, sh.Answered, sh.Damage, sh.HP); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (s *sqlStore) GetTopFleets(limit int) ([]FleetEntry, error) {
+	rows, err := s.db.Query(
+		`SELECT `+s.d.nameList("u.name", "m.id")+`, r.difficulty, r.team_score, r.enemy_sunk
+		 FROM fleet_results r
+		 JOIN fleet_members m ON m.result_id = r.id
+		 JOIN users u ON m.user_id = u.id
+		 GROUP BY r.id, r.difficulty, r.team_score, r.enemy_sunk
+		 ORDER BY r.enemy_sunk DESC, r.team_score DESC, r.id
+		 LIMIT ?`, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var entries []FleetEntry
+	for rows.Next() {
+		var e FleetEntry
+		if err := rows.Scan(&e.Members, &e.Difficulty, &e.TeamScore, &e.EnemySunk); err != nil {
+			return nil, err
+		}
+		e.Members = strings.TrimSpace(e.Members)
+		entries = append(entries, e)
+	}
+	return entries, rows.Err()
+}
+
+func (s *sqlStore) SaveClassReport(r ClassReport) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	var host interface{}
+	if r.HostUserID > 0 {
+		host = r.HostUserID
+	}
+	res, err := tx.Exec(
+		"INSERT INTO class_sessions (room_code, host_id, subject, difficulty, questions, students, accuracy, flagship_sunk) VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
+		r.RoomCode, host, r.Subject, r.Difficulty, r.Questions, len(r.Students), r.Accuracy(), r.FlagshipSunk,
+	)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	sessionID, err := res.LastInsertId()
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	stmt, err := tx.Prepare("INSERT INTO class_session_students (session_id, name, score, correct, answered, avg_response_ms, hp) VALUES (?, ?, ?, ?, ?, ?, ?)")
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	defer stmt.Close()
+	for _, st := range r.Students {
+		if _, err := stmt.Exec(sessionID, st.Name, st.Score, st.Correct, st.Answered, st.AvgResponse().Milliseconds(), st.HP); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (s *sqlStore) GetRating(userID int64, subject string) (Rating, error) {
+	r := Rating{Subject: subject, Rating: RatingStart}
+	err := s.db.QueryRow("SELECT rating, games FROM ratings WHERE user_id = ? AND subject = ?", userID, subject).Scan(&r.Rating, &r.Games)
+	if err == sql.ErrNoRows {
+		return r, nil
+	}
+	return r, err
+}
+
+func (s *sqlStore) SaveRating(userID int64, r Rating) error {
+	_, err := s.db.Exec(
+		"INSERT INTO ratings (user_id, subject, rating, games) VALUES (?, ?, ?, ?) "+s.d.upsert("user_id", "subject")+
+			" rating = "+s.d.inserted("rating")+", games = "+s.d.inserted("games")+", updated_at = CURRENT_TIMESTAMP",
+		userID, r.Subject, r.Rating, r.Games,
+	)
+	return err
+}
+
+func (s *sqlStore) GetRatings(userID int64) ([]Rating, error) {
+	rows, err := s.db.Query("SELECT subject, rating, games FROM ratings WHERE user_id = ? ORDER BY rating DESC, subject", userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var ratings []Rating
+	for rows.Next() {
+		var r Rating
+		if err := rows.Scan(&r.Subject, &r.Rating, &r.Games); err != nil {
+			return nil, err
+		}
+		ratings = append(ratings, r)
+	}
+	return ratings, rows.Err()
+}
+
+func (s *sqlStore) GetTopRatings(limit int) ([]RatingEntry, error) {
+	rows, err := s.db.Query(
+		`SELECT u.name, r.subject, r.rating, r.games
+		 FROM ratings r
+		 JOIN users u ON r.user_id = u.id
+		 WHERE r.games >= ?
+		 ORDER BY r.rating DESC, r.user_id, r.subject
+		 LIMIT ?`, RatingMinGames, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var entries []RatingEntry
+	for rows.Next() {
+		var e RatingEntry
+		var rating float64
+		if err := rows.Scan(&e.PlayerName, &e.Subject, &rating, &e.Games); err != nil {
+			return nil, err
+		}
+		e.Rating = roundRating(rating)
+		entries = append(entries, e)
+	}
+	return entries, rows.Err()
+}
@@ -1,5 +1,28 @@
 package game
 
+import "time"
+
+// Mode identifies the kind of battle being played
+type Mode string
+
+// Battle modes
+const (
+	ModeStandard   Mode = "standard"
+	ModeCampaign   Mode = "campaign"
+	ModeBoss       Mode = "boss"
+	ModePractice   Mode = "practice"
+	ModeSurvival   Mode = "survival"
+	ModeTimeAttack Mode = "timeattack"
+	ModeDaily      Mode = "daily"
+	ModeVersus     Mode = "versus"
+	ModeParty      Mode = "party"
+	ModeClass      Mode = "class"
+	ModeFleet      Mode = "fleet"
+)
+
+// QuestionTimeLimit is the default time to answer a question
+const QuestionTimeLimit = 10 * time.Second
+
 // GameState tracks the current game session
 type GameState struct {
 	PlayerName      string
@@ -8,6 +31,7 @@ type GameState struct {
 	Shields         int
 	HP              int
 	Streak          int
+	LongestStreak   int
 	Weapon          Weapon
 	QuestsCompleted int
 	WeaponBoosts    int
@@ -39,6 +63,9 @@ func (gs *GameState) RecordAnswer(correct bool) {
 	if correct {
 		gs.CorrectAnswers++
 		gs.Streak++
+		if gs.Streak > gs.LongestStreak {
+			gs.LongestStreak = gs.Streak
+		}
 	} else {
 		gs.Streak = 0
 	}
@@ -76,12 +103,18 @@ func (gs *GameState) BonusSuccessRate() float64 {
 
 // LeaderboardEntry represents a leaderboard record
 type LeaderboardEntry struct {
+	UserID          int64
 	PlayerName      string
 	Score           int
 	QuestsCompleted int
 	WeaponBoosts    int
 	Accuracy        float64
 	BonusSuccess    float64
+	LongestStreak   int
+	PowerUpsUsed    int
+	Mode            Mode
+	Subject         string
+	Difficulty      string
 }
 
 // NewLeaderboardEntry creates a leaderboard entry from a game state
@@ -93,6 +126,7 @@ func NewLeaderboardEntry(gs *GameState) LeaderboardEntry {
 		WeaponBoosts:    gs.WeaponBoosts,
 		Accuracy:        gs.Accuracy(),
 		BonusSuccess:    gs.BonusSuccessRate(),
+		LongestStreak:   gs.LongestStreak,
 	}
 }
 
@@ -106,7 +140,7 @@ func CalcRankAndPercent(score, mainQDone int, bonusAnswered bool, enemyHP, playe
 		percent = (score * 100) / maxScore
 	}
 	// If enemy defeated, force 100%
-	if enemyHP == 0 {
+	if enemyHP == 0 || percent > 100 {
 		percent = 100
 	}
 	if playerHP == 0 || percent < 50 {
@@ -150,8 +184,8 @@ func InitCombatState(difficulty string) CombatInitState {
 		PlayerHP:         100,
 		PlayerMaxShields: 3,
 		PlayerShields:    3,
-		EnemyMaxHP:       100,
-		EnemyHP:          100,
+		EnemyMaxHP:       GetEnemyClass(level).MaxHP,
+		EnemyHP:          GetEnemyClass(level).MaxHP,
 		BonusActive:      false,
 		BonusAnswered:    false,
 		BonusQDone:       false,
@@ -0,0 +1,139 @@
+package game
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// Store keeps players, results and progress. MySQL suits a school server, SQLite a
+// single machine, and the in-memory store a session that should leave nothing behind.
+type Store interface {
+	InsertUser(name string) (int64, error)
+	// FindUsers returns the accounts with a name, ignoring case, most played first
+	FindUsers(name string) ([]Account, error)
+	SetUserPIN(userID int64, hash string) error
+
+	InsertLeaderboard(userID int64, e LeaderboardEntry) error
+	// GetLeaderboard returns a page of the battles that match the query, best first
+	GetLeaderboard(q LeaderboardQuery) (LeaderboardPage, error)
+	// GetLeaderboardRank returns where a user's best battle that matches the query
+	// ranks, ignoring the query's offset and limit; rank 0 if they have none
+	GetLeaderboardRank(q LeaderboardQuery, userID int64) (rank int, best LeaderboardEntry, err error)
+	// GetPersonalBests returns a user's best score in each mode, subject and difficulty, best first
+	GetPersonalBests(userID int64) ([]PersonalBest, error)
+	// ClassHost returns the teacher whose class a captain is in: the captain if they
+	// hosted a class session, else the host of the latest one they joined; 0 if none
+	ClassHost(userID int64, name string) (int64, error)
+	// GetTotalScore returns the total score from all battles of a user
+	GetTotalScore(userID int64) (int, error)
+
+	// InsertAttempts logs a finished battle and its answers in one transaction
+	InsertAttempts(b Battle, records []AnswerRecord) (battleID int64, err error)
+	GetBattleAttempts(battleID int64) ([]Attempt, error)
+	// GetUserAttempts returns a user's latest answers, newest first
+	GetUserAttempts(userID int64, limit int) ([]Attempt, error)
+	// GetQuestionStats sums up the attempts at each question, the most missed first.
+	// An empty subject or difficulty matches all.
+	GetQuestionStats(subject, difficulty string) ([]QuestionStats, error)
+
+	HasCompletedDifficulty(userID int64, subject, difficulty string) (bool, error)
+	// MarkDifficultyCompleted records a completed subject, keeping the best stars
+	MarkDifficultyCompleted(userID int64, subject, difficulty string, stars float64) error
+	GetCompletions(userID int64) ([]Completion, error)
+
+	// RecordBossVictory stores a boss win as the milestone for completing a difficulty tier
+	RecordBossVictory(userID int64, difficulty string, score int) error
+	HasDefeatedBoss(userID int64, difficulty string) (bool, error)
+
+	SaveCampaignProgress(userID int64, r *CampaignRun) error
+	// LoadCampaignProgress returns nil if the user hasn't started the campaign
+	LoadCampaignProgress(userID int64, campaignID string) (*CampaignRun, error)
+
+	SavePracticeSession(userID int64, p *PracticeSession) error
+
+	// StartDailyAttempt returns ErrDailyPlayed if the user already took the challenge
+	StartDailyAttempt(userID int64, c DailyChallenge) error
+	SaveDailyResult(userID int64, date string, score, longestStreak int) error
+	HasPlayedDaily(userID int64, date string) (bool, error)
+	GetDailyLeaderboard(date string, limit int) ([]LeaderboardEntry, error)
+	// GetDailyHistory returns every daily challenge a user played, oldest first
+	GetDailyHistory(userID int64) ([]DailyResult, error)
+
+	InsertSurvivalRun(userID int64, score, waves int, subjects string) error
+	// GetTopSurvival ranks by score, then waves survived
+	GetTopSurvival(limit int) ([]SurvivalEntry, error)
+	InsertTimeAttack(userID int64, r *TimeAttackRun) error
+	GetTopTimeAttack(limit int) ([]TimeAttackEntry, error)
+
+	InsertVersusMatch(m *VersusMatch, difficulty string) error
+	InsertFleetResult(f *Fleet, difficulty string) error
+	// GetTopFleets returns the best teams, victories first
+	GetTopFleets(limit int) ([]FleetEntry, error)
+	SaveClassReport(r ClassReport) error
+
+	// GetRating returns RatingStart for a subject the user has no rating in yet
+	GetRating(userID int64, subject string) (Rating, error)
+	SaveRating(userID int64, r Rating) error
+	// GetRatings returns a user's ratings, best first
+	GetRatings(userID int64) ([]Rating, error)
+	// GetTopRatings returns the highest ratings with at least RatingMinGames battles
+	GetTopRatings(limit int) ([]RatingEntry, error)
+
+	// Migrate brings the schema up to date and returns its version before and after
+	Migrate() (from, to int, err error)
+	Close() error
+}
+
+// Store drivers
+const (
+	DriverMySQL  = "mysql"
+	DriverSQLite = "sqlite"
+	DriverMemory = "memory"
+)
+
+// StoreConfig picks the store: a driver and its data source, a MySQL DSN or
+// the path of the SQLite file
+type StoreConfig struct {
+	Driver string `json:"driver"`
+	DSN    string `json:"dsn"`
+}
+
+// DefaultStoreConfig keeps the data in a SQLite file next to the game
+var DefaultStoreConfig = StoreConfig{Driver: DriverSQLite, DSN: "broadside.db"}
+
+// LoadStoreConfig reads the config file at path; a missing file means the default.
+// BROADSIDE_STORE and BROADSIDE_DSN override the file.
+func LoadStoreConfig(path string) (StoreConfig, error) {
+	cfg := DefaultStoreConfig
+	data, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return cfg, err
+	}
+	if err == nil {
+		if err := json.Unmarshal(data, &cfg); err != nil {
+			return cfg, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+	if v := os.Getenv("BROADSIDE_STORE"); v != "" {
+		cfg.Driver = v
+	}
+	if v := os.Getenv("BROADSIDE_DSN"); v != "" {
+		cfg.DSN = v
+	}
+	return cfg, nil
+}
+
+// OpenStore opens the store the config asks for
+func OpenStore(cfg StoreConfig) (Store, error) {
+	switch cfg.Driver {
+	case DriverMySQL:
+		return openSQLStore("mysql", cfg.DSN, mysqlDialect)
+	case DriverSQLite:
+		return openSQLStore("sqlite", cfg.DSN, sqliteDialect)
+	case DriverMemory:
+		return NewMemoryStore(), nil
+	}
+	return nil, fmt.Errorf("unknown store driver %q", cfg.Driver)
+}
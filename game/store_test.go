@@ -0,0 +1,786 @@
+package game
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// storeBackend opens an empty, migrated store
+type storeBackend struct {
+	name string
+	open func(t *testing.T) Store
+}
+
+// storeBackends are the stores every case runs against. MySQL runs only when
+// BROADSIDE_TEST_MYSQL_DSN names a database the test may empty.
+func storeBackends() []storeBackend {
+	backends := []storeBackend{
+		{"memory", func(t *testing.T) Store { return NewMemoryStore() }},
+		{"sqlite", func(t *testing.T) Store {
+			s, err := openSQLStore("sqlite", filepath.Join(t.TempDir(), "broadside.db"), sqliteDialect)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return migrated(t, s)
+		}},
+	}
+	if dsn := os.Getenv("BROADSIDE_TEST_MYSQL_DSN"); dsn != "" {
+		backends = append(backends, storeBackend{"mysql", func(t *testing.T) Store {
+			s, err := openSQLStore("mysql", dsn, mysqlDialect)
+			if err != nil {
+				t.Fatal(err)
+			}
+			dropTables(t, s)
+			return migrated(t, s)
+		}})
+	}
+	return backends
+}
+
+func migrated(t *testing.T, s *sqlStore) Store {
+	t.Cleanup(func() { s.Close() })
+	if _, _, err := s.Migrate(); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	return s
+}
+
+// dropTables empties a MySQL database, so each case starts from no schema
+func dropTables(t *testing.T, s *sqlStore) {
+	ctx := context.Background()
+	conn, err := s.db.Conn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	rows, err := conn.QueryContext(ctx, "SELECT TABLE_NAME FROM information_schema.TABLES WHERE TABLE_SCHEMA = DATABASE()")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var tables []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			t.Fatal(err)
+		}
+		tables = append(tables, "`"+name+"`")
+	}
+	rows.Close()
+	if len(tables) == 0 {
+		return
+	}
+	for _, stmt := range []string{"SET FOREIGN_KEY_CHECKS = 0", "DROP TABLE " + strings.Join(tables, ", "), "SET FOREIGN_KEY_CHECKS = 1"} {
+		if _, err := conn.ExecContext(ctx, stmt); err != nil {
+			t.Fatalf("%s: %v", stmt, err)
+		}
+	}
+}
+
+func TestStores(t *testing.T) {
+	cases := []struct {
+		name string
+		run  func(t *testing.T, s Store)
+	}{
+		{"accounts", testAccounts},
+		{"leaderboard", testLeaderboard},
+		{"personal bests", testPersonalBests},
+		{"progress", testProgress},
+		{"attempts", testAttempts},
+		{"campaign and practice", testCampaign},
+		{"daily", testDaily},
+		{"survival and time attack", testArcade},
+		{"versus and fleets", testTeams},
+		{"ratings", testRatings},
+		{"migrate again", testMigrateAgain},
+	}
+	for _, b := range storeBackends() {
+		t.Run(b.name, func(t *testing.T) {
+			for _, c := range cases {
+				t.Run(c.name, func(t *testing.T) { c.run(t, b.open(t)) })
+			}
+		})
+	}
+}
+
+func TestMySQLDSN(t *testing.T) {
+	cases := []struct {
+		dsn, want string
+	}{
+		{"broadside:secret@tcp(db:3306)/broadside", "broadside:secret@tcp(db:3306)/broadside?time_zone=%27%2B00%3A00%27"},
+		{"broadside@tcp(db)/broadside?parseTime=true", "broadside@tcp(db:3306)/broadside?parseTime=true&time_zone=%27%2B00%3A00%27"},
+		// A DSN that names a zone keeps it
+		{"broadside@/broadside?time_zone=%27Asia%2FManila%27", "broadside@tcp(127.0.0.1:3306)/broadside?time_zone=%27Asia%2FManila%27"},
+	}
+	for _, c := range cases {
+		got, err := mysqlDSN(c.dsn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != c.want {
+			t.Errorf("mysqlDSN(%q) = %q, want %q", c.dsn, got, c.want)
+		}
+	}
+	if _, err := mysqlDSN("not a dsn"); err == nil {
+		t.Error("mysqlDSN accepted a malformed DSN")
+	}
+}
+
+func testAccounts(t *testing.T, s Store) {
+	first, err := s.InsertUser("Ana")
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := s.InsertUser("Ana")
+	if err != nil {
+		t.Fatal(err)
+	}
+	locked, err := CreateAccount(s, "ana", "1234")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.InsertLeaderboard(second, LeaderboardEntry{Score: 50}); err != nil {
+		t.Fatal(err)
+	}
+
+	accounts, err := s.FindUsers(" ANA ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []int64
+	for _, a := range accounts {
+		ids = append(ids, a.ID)
+	}
+	if want := []int64{second, first, locked.ID}; !reflect.DeepEqual(ids, want) {
+		t.Fatalf("FindUsers ids = %v, want %v (most played first)", ids, want)
+	}
+	if a := accounts[0]; a.Battles != 1 || a.Score != 50 || a.HasPIN() {
+		t.Errorf("most played account = %+v", a)
+	}
+	if !accounts[2].HasPIN() || accounts[2].Unlock("1234") != nil || accounts[2].Unlock("0000") == nil {
+		t.Errorf("PIN account = %+v", accounts[2])
+	}
+
+	if id, err := SeatAccount(s, "ana"); err != nil {
+		t.Fatal(err)
+	} else if id != second {
+		t.Errorf("SeatAccount(ana) = %d, want the open account played most, %d", id, second)
+	}
+	zed, err := SeatAccount(s, "Zed")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found, err := s.FindUsers("zed"); err != nil {
+		t.Fatal(err)
+	} else if len(found) != 1 || found[0].ID != zed {
+		t.Errorf("SeatAccount(Zed) = %d, FindUsers = %+v", zed, found)
+	}
+
+	hash, err := HashPIN("4321")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.SetUserPIN(zed, hash); err != nil {
+		t.Fatal(err)
+	}
+	if found, err := s.FindUsers("Zed"); err != nil {
+		t.Fatal(err)
+	} else if len(found) != 1 || found[0].Unlock("4321") != nil || found[0].Unlock("1234") == nil {
+		t.Errorf("Zed after SetUserPIN = %+v", found)
+	}
+}
+
+// leaderboardNames lists the captains and scores on a page, in order
+func leaderboardNames(p LeaderboardPage) []string {
+	var names []string
+	for _, e := range p.Entries {
+		names = append(names, e.PlayerName+":"+strconv.Itoa(e.Score))
+	}
+	return names
+}
+
+func testLeaderboard(t *testing.T, s Store) {
+	ana, err := s.InsertUser("Ana")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ben, err := s.InsertUser("Ben")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cy, err := s.InsertUser("Cy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows := []struct {
+		user int64
+		e    LeaderboardEntry
+	}{
+		{ana, LeaderboardEntry{Score: 300, Subject: "Math", Difficulty: "Easy"}},
+		{ana, LeaderboardEntry{Score: 500, Subject: "English", Difficulty: "Easy", QuestsCompleted: 10, WeaponBoosts: 2,
+			Accuracy: 87.5, BonusSuccess: 50, LongestStreak: 6, PowerUpsUsed: 1, Mode: ModeStandard}},
+		{ben, LeaderboardEntry{Score: 400, Subject: "Math", Difficulty: "Easy"}},
+		{ben, LeaderboardEntry{Score: 100, Subject: "Math", Difficulty: "Hard"}},
+		{cy, LeaderboardEntry{Score: 400, Subject: "Math", Difficulty: "Easy"}},
+	}
+	for _, r := range rows {
+		if err := s.InsertLeaderboard(r.user, r.e); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := s.SaveClassReport(ClassReport{RoomCode: "ABCD", HostUserID: ana, Subject: "Math", Difficulty: "Easy",
+		Students: []ClassStudent{{Name: "cy"}}}); err != nil {
+		t.Fatal(err)
+	}
+
+	pages := []struct {
+		name  string
+		q     LeaderboardQuery
+		want  []string
+		total int
+	}{
+		// Ben and Cy tie; Ben's battle was saved first
+		{"all", LeaderboardQuery{Limit: 10}, []string{"Ana:500", "Ben:400", "Cy:400", "Ana:300", "Ben:100"}, 5},
+		{"subject", LeaderboardQuery{Subject: "Math", Limit: 10}, []string{"Ben:400", "Cy:400", "Ana:300", "Ben:100"}, 4},
+		{"subject and difficulty", LeaderboardQuery{Subject: "Math", Difficulty: "Easy", Limit: 10}, []string{"Ben:400", "Cy:400", "Ana:300"}, 3},
+		{"second page", LeaderboardQuery{Offset: 2, Limit: 2}, []string{"Cy:400", "Ana:300"}, 5},
+		{"past the end", LeaderboardQuery{Offset: 10, Limit: 2}, nil, 5},
+		{"window holds every row", LeaderboardQuery{Since: time.Now().Add(-time.Hour), Limit: 10}, []string{"Ana:500", "Ben:400", "Cy:400", "Ana:300", "Ben:100"}, 5},
+		{"window after every row", LeaderboardQuery{Since: time.Now().Add(time.Hour), Limit: 10}, nil, 0},
+		{"class", LeaderboardQuery{ClassHost: ana, Limit: 10}, []string{"Ana:500", "Cy:400", "Ana:300"}, 3},
+	}
+	for _, p := range pages {
+		page, err := s.GetLeaderboard(p.q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := leaderboardNames(page); !reflect.DeepEqual(got, p.want) || page.Total != p.total || page.Offset != p.q.Offset {
+			t.Errorf("%s: got %v total %d offset %d, want %v total %d offset %d", p.name, got, page.Total, page.Offset, p.want, p.total, p.q.Offset)
+		}
+	}
+
+	page, err := s.GetLeaderboard(LeaderboardQuery{Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	top := page.Entries[0]
+	want := rows[1].e
+	want.UserID, want.PlayerName = ana, "Ana"
+	if top != want {
+		t.Errorf("top entry = %+v, want %+v", top, want)
+	}
+
+	ranks := []struct {
+		name  string
+		q     LeaderboardQuery
+		user  int64
+		rank  int
+		score int
+	}{
+		{"best of two", LeaderboardQuery{}, ana, 1, 500},
+		{"behind a tie", LeaderboardQuery{}, cy, 3, 400},
+		{"paging is ignored", LeaderboardQuery{Offset: 4, Limit: 1}, cy, 3, 400},
+		{"subject", LeaderboardQuery{Subject: "Math"}, ana, 3, 300},
+		{"class", LeaderboardQuery{ClassHost: ana}, cy, 2, 400},
+		{"outside the class", LeaderboardQuery{ClassHost: ana}, ben, 0, 0},
+		{"outside the window", LeaderboardQuery{Since: time.Now().Add(time.Hour)}, ana, 0, 0},
+	}
+	for _, r := range ranks {
+		rank, best, err := s.GetLeaderboardRank(r.q, r.user)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rank != r.rank || best.Score != r.score {
+			t.Errorf("%s: rank %d score %d, want rank %d score %d", r.name, rank, best.Score, r.rank, r.score)
+		}
+	}
+
+	hosts := []struct {
+		user int64
+		name string
+		want int64
+	}{
+		{ana, "Ana", ana},
+		{cy, "CY", ana},
+		{ben, "Ben", 0},
+	}
+	for _, h := range hosts {
+		if got, err := s.ClassHost(h.user, h.name); err != nil {
+			t.Fatal(err)
+		} else if got != h.want {
+			t.Errorf("ClassHost(%s) = %d, want %d", h.name, got, h.want)
+		}
+	}
+}
+
+func testPersonalBests(t *testing.T, s Store) {
+	ana, err := s.InsertUser("Ana")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ben, err := s.InsertUser("Ben")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range []LeaderboardEntry{
+		{Score: 300, Mode: ModeStandard, Subject: "Math", Difficulty: "Easy"},
+		{Score: 200, Mode: ModeStandard, Subject: "Math", Difficulty: "Easy"},
+		{Score: 500, Mode: ModeStandard, Subject: "English", Difficulty: "Easy"},
+		{Score: 300, Mode: ModeBoss, Subject: "Math", Difficulty: "Easy"},
+	} {
+		if err := s.InsertLeaderboard(ana, e); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := s.InsertLeaderboard(ben, LeaderboardEntry{Score: 900, Mode: ModeStandard, Subject: "Math", Difficulty: "Easy"}); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := s.GetPersonalBests(ana)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []PersonalBest{
+		{Mode: ModeStandard, Subject: "English", Difficulty: "Easy", Score: 500, Battles: 1},
+		{Mode: ModeBoss, Subject: "Math", Difficulty: "Easy", Score: 300, Battles: 1},
+		{Mode: ModeStandard, Subject: "Math", Difficulty: "Easy", Score: 300, Battles: 2},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetPersonalBests = %+v, want %+v", got, want)
+	}
+	if total, err := s.GetTotalScore(ana); err != nil {
+		t.Fatal(err)
+	} else if total != 1300 {
+		t.Errorf("GetTotalScore = %d, want 1300", total)
+	}
+}
+
+func testProgress(t *testing.T, s Store) {
+	ana, err := s.InsertUser("Ana")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []Completion{
+		{"Math", "Easy", 2},
+		{"Math", "Easy", 1}, // a worse result keeps the best
+		{"English", "Easy", 1.5},
+		{"English", "Easy", 3},
+	} {
+		if err := s.MarkDifficultyCompleted(ana, c.Subject, c.Difficulty, c.Stars); err != nil {
+			t.Fatal(err)
+		}
+	}
+	got, err := s.GetCompletions(ana)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Slice(got, func(i, j int) bool { return got[i].Subject < got[j].Subject })
+	if want := []Completion{{"English", "Easy", 3}, {"Math", "Easy", 2}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("GetCompletions = %+v, want %+v", got, want)
+	}
+	for _, c := range []struct {
+		difficulty string
+		want       bool
+	}{{"Easy", true}, {"Medium", false}} {
+		if done, err := s.HasCompletedDifficulty(ana, "Math", c.difficulty); err != nil || done != c.want {
+			t.Errorf("HasCompletedDifficulty(Math, %s) = %v, %v, want %v", c.difficulty, done, err, c.want)
+		}
+	}
+
+	if beaten, err := s.HasDefeatedBoss(ana, "Easy"); err != nil || beaten {
+		t.Errorf("HasDefeatedBoss before any victory = %v, %v", beaten, err)
+	}
+	if err := s.RecordBossVictory(ana, "Easy", 800); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.RecordBossVictory(ana, "Easy", 600); err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []struct {
+		difficulty string
+		want       bool
+	}{{"Easy", true}, {"Medium", false}} {
+		if beaten, err := s.HasDefeatedBoss(ana, c.difficulty); err != nil || beaten != c.want {
+			t.Errorf("HasDefeatedBoss(%s) = %v, %v, want %v", c.difficulty, beaten, err, c.want)
+		}
+	}
+}
+
+func testAttempts(t *testing.T, s Store) {
+	ana, err := s.InsertUser("Ana")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id, err := s.InsertAttempts(Battle{UserID: ana}, nil); err != nil {
+		t.Fatal(err)
+	} else if id != 0 {
+		t.Errorf("a battle without answers was logged as %d", id)
+	}
+
+	battle := Battle{UserID: ana, Mode: ModeStandard, Subject: "Math", Difficulty: "Easy"}
+	records := []AnswerRecord{
+		{Question: "What is 2 + 3?", Chosen: "5", Correct: true, ResponseTime: 2 * time.Second, SpeedBonus: 10,
+			PowerUps: []PowerUp{PowerFiftyFifty, PowerSecondChance}},
+		{Question: "Which word is a noun?", Subject: "English", Chosen: "", TimedOut: true, ResponseTime: 10 * time.Second, HintUsed: true},
+	}
+	first, err := s.InsertAttempts(battle, records)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := s.GetBattleAttempts(first)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var want []Attempt
+	for _, r := range records {
+		want = append(want, Attempt{BattleID: first, UserID: ana, AnswerRecord: battle.fill(r)})
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetBattleAttempts = %+v, want %+v", got, want)
+	}
+
+	second, err := s.InsertAttempts(battle, []AnswerRecord{{Question: "What is 2 + 3?", Chosen: "4", ResponseTime: 4 * time.Second}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second == first {
+		t.Fatalf("both battles were logged as %d", first)
+	}
+	if latest, err := s.GetUserAttempts(ana, 1); err != nil {
+		t.Fatal(err)
+	} else if len(latest) != 1 || latest[0].BattleID != second {
+		t.Errorf("GetUserAttempts = %+v, want the answer of battle %d", latest, second)
+	}
+
+	stats, err := s.GetQuestionStats("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantStats := []QuestionStats{
+		{QuestionID: QuestionID("English", "Which word is a noun?"), Subject: "English", Question: "Which word is a noun?",
+			Attempts: 1, TimedOut: 1, HintsUsed: 1, AvgResponse: 10 * time.Second},
+		{QuestionID: QuestionID("Math", "What is 2 + 3?"), Subject: "Math", Question: "What is 2 + 3?",
+			Attempts: 2, Correct: 1, AvgResponse: 3 * time.Second},
+	}
+	if !reflect.DeepEqual(stats, wantStats) {
+		t.Errorf("GetQuestionStats = %+v, want %+v", stats, wantStats)
+	}
+	if math, err := s.GetQuestionStats("Math", "Easy"); err != nil {
+		t.Fatal(err)
+	} else if len(math) != 1 || math[0].Subject != "Math" {
+		t.Errorf("GetQuestionStats(Math, Easy) = %+v", math)
+	}
+}
+
+func testCampaign(t *testing.T, s Store) {
+	ana, err := s.InsertUser("Ana")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r, err := s.LoadCampaignProgress(ana, "caribbean"); err != nil || r != nil {
+		t.Fatalf("LoadCampaignProgress before any save = %+v, %v", r, err)
+	}
+	run := &CampaignRun{CampaignID: "caribbean", Current: "tortuga", HP: 80, Shields: 2,
+		Completed: map[string]bool{"port-royal": true, "nassau": true, "havana": false}}
+	if err := s.SaveCampaignProgress(ana, run); err != nil {
+		t.Fatal(err)
+	}
+	// Saving again overwrites the run
+	run.Current, run.HP = "havana", 60
+	run.Completed["tortuga"] = true
+	if err := s.SaveCampaignProgress(ana, run); err != nil {
+		t.Fatal(err)
+	}
+	got, err := s.LoadCampaignProgress(ana, "caribbean")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &CampaignRun{CampaignID: "caribbean", Current: "havana", HP: 60, Shields: 2,
+		Completed: map[string]bool{"port-royal": true, "nassau": true, "tortuga": true}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("LoadCampaignProgress = %+v, want %+v", got, want)
+	}
+	if r, err := s.LoadCampaignProgress(ana, "baltic"); err != nil || r != nil {
+		t.Errorf("LoadCampaignProgress of another campaign = %+v, %v", r, err)
+	}
+
+	if err := s.SavePracticeSession(ana, &PracticeSession{Subject: "Math", Difficulty: "Easy", Correct: 4, Incorrect: 1, Skipped: 2}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testDaily(t *testing.T, s Store) {
+	var users []int64
+	for _, name := range []string{"Ana", "Ben", "Cy"} {
+		id, err := s.InsertUser(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		users = append(users, id)
+	}
+	ana := users[0]
+	yesterday := DailyChallenge{Date: "2026-10-18", BankVersion: 7}
+	today := DailyChallenge{Date: "2026-10-19", BankVersion: 7}
+
+	if played, err := s.HasPlayedDaily(ana, today.Date); err != nil || played {
+		t.Fatalf("HasPlayedDaily before starting = %v, %v", played, err)
+	}
+	for i, score := range []int{300, 500, 300} {
+		if err := s.StartDailyAttempt(users[i], today); err != nil {
+			t.Fatal(err)
+		}
+		if err := s.SaveDailyResult(users[i], today.Date, score, i+2); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := s.StartDailyAttempt(ana, today); !errors.Is(err, ErrDailyPlayed) {
+		t.Errorf("second StartDailyAttempt = %v, want %v", err, ErrDailyPlayed)
+	}
+	if played, err := s.HasPlayedDaily(ana, today.Date); err != nil || !played {
+		t.Errorf("HasPlayedDaily after starting = %v, %v", played, err)
+	}
+	if err := s.StartDailyAttempt(ana, yesterday); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.SaveDailyResult(ana, yesterday.Date, 900, 8); err != nil {
+		t.Fatal(err)
+	}
+
+	board, err := s.GetDailyLeaderboard(today.Date, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Ana and Cy tie; Ana started first
+	want := []LeaderboardEntry{
+		{PlayerName: "Ben", Score: 500, LongestStreak: 3},
+		{PlayerName: "Ana", Score: 300, LongestStreak: 2},
+		{PlayerName: "Cy", Score: 300, LongestStreak: 4},
+	}
+	if !reflect.DeepEqual(board, want) {
+		t.Errorf("GetDailyLeaderboard = %+v, want %+v", board, want)
+	}
+	if top, err := s.GetDailyLeaderboard(today.Date, 1); err != nil {
+		t.Fatal(err)
+	} else if len(top) != 1 || top[0].PlayerName != "Ben" {
+		t.Errorf("GetDailyLeaderboard limited to 1 = %+v", top)
+	}
+
+	history, err := s.GetDailyHistory(ana)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []DailyResult{{yesterday.Date, 900, 8}, {today.Date, 300, 2}}; !reflect.DeepEqual(history, want) {
+		t.Errorf("GetDailyHistory = %+v, want %+v", history, want)
+	}
+}
+
+// testArcade covers the survival and time-attack leaderboards
+func testArcade(t *testing.T, s Store) {
+	ana, err := s.InsertUser("Ana")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ben, err := s.InsertUser("Ben")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cy, err := s.InsertUser("Cy")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, r := range []struct {
+		user         int64
+		score, waves int
+	}{
+		{ana, 500, 5},
+		{ben, 500, 7},
+		{ana, 100, 1},
+		{cy, 500, 7},
+	} {
+		if err := s.InsertSurvivalRun(r.user, r.score, r.waves, "Math,English"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	survival, err := s.GetTopSurvival(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// More waves break a tie in score, then the earlier run
+	wantSurvival := []SurvivalEntry{{"Ben", 500, 7}, {"Cy", 500, 7}, {"Ana", 500, 5}}
+	if !reflect.DeepEqual(survival, wantSurvival) {
+		t.Errorf("GetTopSurvival = %+v, want %+v", survival, wantSurvival)
+	}
+
+	runs := []struct {
+		user int64
+		run  TimeAttackRun
+	}{
+		{ana, TimeAttackRun{Difficulty: "Easy", Correct: 10, Wrong: 2}},
+		{ben, TimeAttackRun{Difficulty: "Hard", Correct: 12}},
+		{cy, TimeAttackRun{Difficulty: "Easy", Correct: 10, Wrong: 2}},
+		{cy, TimeAttackRun{Difficulty: "Easy", Wrong: 5}},
+	}
+	for _, r := range runs {
+		if err := s.InsertTimeAttack(r.user, &r.run); err != nil {
+			t.Fatal(err)
+		}
+	}
+	timeAttack, err := s.GetTopTimeAttack(10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantTimeAttack := []TimeAttackEntry{
+		{"Ben", runs[1].run.Score(), 12},
+		{"Ana", runs[0].run.Score(), 10},
+		{"Cy", runs[2].run.Score(), 10},
+		{"Cy", 0, 0},
+	}
+	if !reflect.DeepEqual(timeAttack, wantTimeAttack) {
+		t.Errorf("GetTopTimeAttack = %+v, want %+v", timeAttack, wantTimeAttack)
+	}
+}
+
+// testTeams covers versus matches and fleet battles
+func testTeams(t *testing.T, s Store) {
+	var users []int64
+	names := []string{"Ana", "Ben", "Cy"}
+	for _, name := range names {
+		id, err := s.InsertUser(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		users = append(users, id)
+	}
+
+	won := NewVersusMatch([2]string{"Ana", "Ben"}, [2]int64{users[0], users[1]}, 1)
+	won.Players[1].HP = 0
+	draw := NewVersusMatch([2]string{"Ben", "Cy"}, [2]int64{users[1], users[2]}, 1)
+	for _, m := range []*VersusMatch{won, draw} {
+		if err := s.InsertVersusMatch(m, "Easy"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	fleet := func(score int, victory bool, ships ...int) *Fleet {
+		var crew []string
+		var ids []int64
+		for _, i := range ships {
+			crew = append(crew, names[i])
+			ids = append(ids, users[i])
+		}
+		f := NewFleet(crew, ids, make([]string, len(ships)), "Easy")
+		f.Ships[0].Score = score
+		if victory {
+			f.EnemyHP = 0
+		}
+		return f
+	}
+	fleets := []*Fleet{
+		fleet(900, false, 0, 1),
+		fleet(100, true, 2, 0),
+		fleet(100, true, 1, 2),
+		fleet(50, false, 0, 1, 2),
+	}
+	for _, f := range fleets {
+		if err := s.InsertFleetResult(f, "Easy"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	got, err := s.GetTopFleets(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Victories first; the two with equal scores in the order they sailed
+	want := []FleetEntry{
+		{"Cy, Ana", "Easy", fleets[1].TeamScore(), true},
+		{"Ben, Cy", "Easy", fleets[2].TeamScore(), true},
+		{"Ana, Ben", "Easy", 900, false},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetTopFleets = %+v, want %+v", got, want)
+	}
+}
+
+func testRatings(t *testing.T, s Store) {
+	ana, err := s.InsertUser("Ana")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ben, err := s.InsertUser("Ben")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r, err := s.GetRating(ana, "Math"); err != nil {
+		t.Fatal(err)
+	} else if r != (Rating{Subject: "Math", Rating: RatingStart}) {
+		t.Errorf("GetRating before any battle = %+v", r)
+	}
+	for _, r := range []struct {
+		user int64
+		r    Rating
+	}{
+		{ana, Rating{"Math", 1250, 1}},
+		{ana, Rating{"Math", 1300.4, RatingMinGames}}, // saving again replaces the rating
+		{ana, Rating{"English", 1300.4, RatingMinGames + 2}},
+		{ana, Rating{"Science", 1400, RatingMinGames - 1}},
+		{ben, Rating{"English", 1300.4, RatingMinGames}},
+		{ben, Rating{"Math", 1350.6, RatingMinGames}},
+	} {
+		if err := s.SaveRating(r.user, r.r); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if r, err := s.GetRating(ana, "Math"); err != nil {
+		t.Fatal(err)
+	} else if r != (Rating{"Math", 1300.4, RatingMinGames}) {
+		t.Errorf("GetRating after saving = %+v", r)
+	}
+
+	ratings, err := s.GetRatings(ana)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A tie in rating goes by subject
+	wantRatings := []Rating{{"Science", 1400, RatingMinGames - 1}, {"English", 1300.4, RatingMinGames + 2}, {"Math", 1300.4, RatingMinGames}}
+	if !reflect.DeepEqual(ratings, wantRatings) {
+		t.Errorf("GetRatings = %+v, want %+v", ratings, wantRatings)
+	}
+
+	// Ana's Science rating has too few battles; the three-way tie goes by captain, then subject
+	top, err := s.GetTopRatings(10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantTop := []RatingEntry{
+		{"Ben", "Math", 1351, RatingMinGames},
+		{"Ana", "English", 1300, RatingMinGames + 2},
+		{"Ana", "Math", 1300, RatingMinGames},
+		{"Ben", "English", 1300, RatingMinGames},
+	}
+	if !reflect.DeepEqual(top, wantTop) {
+		t.Errorf("GetTopRatings = %+v, want %+v", top, wantTop)
+	}
+	if top, err := s.GetTopRatings(2); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(top, wantTop[:2]) {
+		t.Errorf("GetTopRatings(2) = %+v, want %+v", top, wantTop[:2])
+	}
+}
+
+func testMigrateAgain(t *testing.T, s Store) {
+	from, to, err := s.Migrate()
+	if err != nil {
+		t.Fatalf("migrate a migrated store: %v", err)
+	}
+	if from != to {
+		t.Errorf("migrating again went from %d to %d", from, to)
+	}
+}
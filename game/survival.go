@@ -0,0 +1,35 @@
+package game
+
+// Survival mode: the player answers until sunk while enemy waves keep coming
+const (
+	SurvivalWavesPerLevel   = 3 // waves sunk before the questions step up a difficulty
+	SurvivalShieldMilestone = 5 // shields regenerate every this many waves sunk
+	SurvivalHitsPerWave     = 5 // correct answers needed to sink a wave without a combo
+)
+
+// SurvivalEntry is a row of the survival leaderboard
+type SurvivalEntry struct {
+	PlayerName string
+	Score      int
+	Waves      int
+}
+
+// SurvivalLevel returns the difficulty level after the given number of waves sunk
+func SurvivalLevel(wavesSunk int) int {
+	level := wavesSunk / SurvivalWavesPerLevel
+	if level > LevelBB {
+		level = LevelBB
+	}
+	return level
+}
+
+// SurvivalEnemyHP returns the hull of a survival wave ship, sized so it sinks
+// after SurvivalHitsPerWave correct answers at that level
+func SurvivalEnemyHP(level int) int {
+	return CalculateDamage(level, Cannon, false) * SurvivalHitsPerWave
+}
+
+// IsShieldMilestone reports whether sinking this many waves regenerates the shields
+func IsShieldMilestone(wavesSunk int) bool {
+	return wavesSunk > 0 && wavesSunk%SurvivalShieldMilestone == 0
+}
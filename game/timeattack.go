@@ -0,0 +1,63 @@
+package game
+
+import "time"
+
+// Time attack: answer as many mixed-subject questions as possible before one
+// global countdown runs out
+const (
+	TimeAttackDuration = 2 * time.Minute
+	TimeAttackPoints   = 10 // points for a correct answer
+	TimeAttackPenalty  = 5  // points lost for a wrong answer
+)
+
+// TimeAttackRun tracks a time-attack blitz
+type TimeAttackRun struct {
+	Difficulty string
+	Start      time.Time
+	Correct    int
+	Wrong      int
+}
+
+// TimeAttackEntry is a row of the time-attack leaderboard
+type TimeAttackEntry struct {
+	PlayerName string
+	Score      int
+	Correct    int
+}
+
+// NewTimeAttackRun starts the countdown of a new run
+func NewTimeAttackRun(difficulty string, start time.Time) *TimeAttackRun {
+	return &TimeAttackRun{Difficulty: difficulty, Start: start}
+}
+
+// Answer counts an answer
+func (r *TimeAttackRun) Answer(correct bool) {
+	if correct {
+		r.Correct++
+	} else {
+		r.Wrong++
+	}
+}
+
+// Score returns the correct answers minus penalties, never below zero
+func (r *TimeAttackRun) Score() int {
+	score := r.Correct*TimeAttackPoints - r.Wrong*TimeAttackPenalty
+	if score < 0 {
+		return 0
+	}
+	return score
+}
+
+// Remaining returns the time left on the countdown
+func (r *TimeAttackRun) Remaining(now time.Time) time.Duration {
+	left := TimeAttackDuration - now.Sub(r.Start)
+	if left < 0 {
+		return 0
+	}
+	return left
+}
+
+// Over reports whether the countdown has run out
+func (r *TimeAttackRun) Over(now time.Time) bool {
+	return r.Remaining(now) == 0
+}
@@ -0,0 +1,113 @@
+package game
+
+import "time"
+
+// Hot-seat versus: two players share one machine, take turns answering and
+// fire on each other's ship with correct answers
+const (
+	VersusRounds    = 10 // questions per player
+	VersusMaxHP     = 100
+	VersusShields   = 3
+	VersusHitDamage = 20 // hull damage of a hit once the shields are down
+)
+
+// VersusPlayer is one side of a versus match
+type VersusPlayer struct {
+	Name     string
+	Seat     Seat // who answers for the player; a person unless a computer captain is seated
+	UserID   int64
+	HP       int
+	Shields  int
+	Score    int
+	Correct  int
+	Answered int
+	Streak   int
+}
+
+// VersusMatch is a hot-seat match between two players
+type VersusMatch struct {
+	Players    [2]VersusPlayer
+	Level      int
+	Turn       int // index of the player answering next
+	LastPlayer int // index of the player who answered last
+}
+
+// NewVersusMatch sets up a match; player 0 answers first
+func NewVersusMatch(names [2]string, userIDs [2]int64, level int) *VersusMatch {
+	m := &VersusMatch{Level: level}
+	for i := range m.Players {
+		m.Players[i] = VersusPlayer{Name: names[i], Seat: Human{Name: names[i]}, UserID: userIDs[i], HP: VersusMaxHP, Shields: VersusShields}
+	}
+	return m
+}
+
+// Answer applies the current player's answer and passes the turn. A correct answer
+// knocks out one of the opponent's shields, or hits the hull once they are down;
+// the combo scales the hull damage. The returned CombatState is seen from the
+// answering player: Player* is the answering side, EnemyHP the opponent's hull.
+func (m *VersusMatch) Answer(isCorrect bool, responseTime, timeLimit time.Duration, hintUsed bool) (cs CombatState, shieldsLost, damage int) {
+	p := &m.Players[m.Turn]
+	opp := &m.Players[1-m.Turn]
+	p.Answered++
+	if isCorrect {
+		p.Streak++
+		p.Correct++
+		mult := ComboMultiplier(p.Streak)
+		if opp.Shields > 0 {
+			opp.Shields--
+			shieldsLost = 1
+		} else {
+			damage = int(float64(VersusHitDamage) * mult)
+			opp.HP -= damage
+			if opp.HP < 0 {
+				opp.HP = 0
+			}
+		}
+		points := basePoints[m.Level]
+		cs.SpeedBonus = SpeedBonusCurve.Bonus(m.Level, responseTime, timeLimit)
+		cs.ComboBonus = int(float64(points)*mult) - points
+		if hintUsed {
+			cs.HintPenalty = HintCost(m.Level)
+		}
+		cs.PointsEarned = points - cs.HintPenalty
+		p.Score += cs.PointsEarned + cs.ComboBonus + cs.SpeedBonus
+	} else {
+		p.Streak = 0
+	}
+	cs.PlayerHP = p.HP
+	cs.PlayerShields = p.Shields
+	cs.EnemyHP = opp.HP
+	cs.Score = p.Score
+	cs.MainQDone = p.Correct
+	cs.Streak = p.Streak
+	cs.Multiplier = ComboMultiplier(p.Streak)
+	m.LastPlayer = m.Turn
+	m.Turn = 1 - m.Turn
+	cs.CombatOver = m.Over()
+	return cs, shieldsLost, damage
+}
+
+// Over reports whether a ship is sunk or both players used all their rounds
+func (m *VersusMatch) Over() bool {
+	a, b := m.Players[0], m.Players[1]
+	return a.HP == 0 || b.HP == 0 || (a.Answered >= VersusRounds && b.Answered >= VersusRounds)
+}
+
+// Winner returns the index of the winning player, or -1 for a draw. A sunk ship
+// loses; otherwise more hull left wins, then the higher score.
+func (m *VersusMatch) Winner() int {
+	a, b := m.Players[0], m.Players[1]
+	switch {
+	case a.HP != b.HP:
+		if a.HP > b.HP {
+			return 0
+		}
+		return 1
+	case a.Score != b.Score:
+		if a.Score > b.Score {
+			return 0
+		}
+		return 1
+	}
+	return -1
+}
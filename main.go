@@ -1,6 +1,7 @@
 package main
 
 import (
+	"flag"
 	"log"
 	"os"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/hajimehoshi/ebiten/v2/audio"
 	"github.com/hajimehoshi/ebiten/v2/audio/wav"
 	"github.com/RALPH22222/Broadside/game"
+	"github.com/RALPH22222/Broadside/netplay"
 	"github.com/RALPH22222/Broadside/ui"
 )
 
@@ -34,9 +36,93 @@ func playBackgroundMusic() {
 	player.Play()
 }
 
+// storeConfig is read from the working directory; see game.LoadStoreConfig
+const storeConfig = "broadside.json"
+
+// openStore opens the configured store and brings its schema up to date. If it
+// cannot be opened the game still runs, keeping its results in memory.
+func openStore() game.Store {
+	cfg, err := game.LoadStoreConfig(storeConfig)
+	if err != nil {
+		log.Printf("failed to read store config: %v", err)
+	}
+	store, err := game.OpenStore(cfg)
+	if err == nil {
+		_, _, err = store.Migrate()
+		if err != nil {
+			store.Close()
+		}
+	}
+	if err != nil {
+		log.Printf("failed to open %s store, results will not be saved: %v", cfg.Driver, err)
+		return game.NewMemoryStore()
+	}
+	return store
+}
+
+// migrate creates or upgrades the configured store's tables: broadside migrate
+func migrate() {
+	cfg, err := game.LoadStoreConfig(storeConfig)
+	if err != nil {
+		log.Fatalf("failed to read store config: %v", err)
+	}
+	store, err := game.OpenStore(cfg)
+	if err != nil {
+		log.Fatalf("failed to open %s store: %v", cfg.Driver, err)
+	}
+	defer store.Close()
+	from, to, err := store.Migrate()
+	if err != nil {
+		log.Fatalf("failed to migrate %s store: %v", cfg.Driver, err)
+	}
+	if from == to {
+		log.Printf("%s schema is up to date at version %d", cfg.Driver, to)
+		return
+	}
+	log.Printf("%s schema migrated from version %d to %d", cfg.Driver, from, to)
+}
+
+// serve runs the LAN match server: broadside serve [-addr :7777] [-difficulty Medium] [-fleet] [-record]
+func serve(args []string) {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+	addr := fs.String("addr", netplay.DefaultAddr, "address to listen on")
+	difficulty := fs.String("difficulty", "Medium", "question difficulty: Easy, Medium, Hard or Extreme")
+	fleet := fs.Bool("fleet", false, "serve co-op fleet battles instead of duels")
+	record := fs.Bool("record", false, "save finished matches to the store")
+	fs.Parse(args)
+	if !game.IsDifficulty(*difficulty) {
+		log.Fatalf("unknown difficulty %q: use Easy, Medium, Hard or Extreme", *difficulty)
+	}
+
+	var store game.Store
+	if *record {
+		store = openStore()
+		defer store.Close()
+	}
+	if *fleet {
+		s := netplay.NewFleetServer(game.NewQuiz(), *difficulty)
+		s.Store = store
+		log.Fatal(s.ListenAndServe(*addr))
+	}
+	s := netplay.NewServer(game.NewQuiz(), *difficulty)
+	s.Store = store
+	log.Fatal(s.ListenAndServe(*addr))
+}
+
 func main() {
-	// Initialize DB connection
-	game.InitDB("root:@tcp(127.0.0.1:3306)/broadside")
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "serve":
+			serve(os.Args[2:])
+			return
+		case "migrate":
+			migrate()
+			return
+		}
+	}
+
+	store := openStore()
+	defer store.Close()
 
 	// Play background music
 	go playBackgroundMusic()
@@ -45,7 +131,7 @@ func main() {
 	ebiten.SetWindowSize(ui.ScreenWidth, ui.ScreenHeight)
 	ebiten.SetWindowTitle("Broadside: Naval Quiz Battle")
 
-	game := ui.NewGame()
+	game := ui.NewGame(store)
 	if err := ebiten.RunGame(game); err != nil {
 		log.Fatal(err)
 	}
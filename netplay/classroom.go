@@ -0,0 +1,452 @@
+package netplay
+
+import (
+	"math/rand"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/RALPH22222/Broadside/game"
+)
+
+// ClassAddr is the address a classroom session listens on
+const ClassAddr = ":7778"
+
+// Letters of room codes, without the ones that are easy to misread
+const roomAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+// ClassPhase is the step a classroom session is at
+type ClassPhase int
+
+const (
+	ClassLobby  ClassPhase = iota // students are joining
+	ClassAsking                   // a question is open
+	ClassReveal                   // the answer is shown
+	ClassOver                     // the session finished
+)
+
+// ClassView is what the host screen shows of a session
+type ClassView struct {
+	Code        string
+	Phase       ClassPhase
+	Round       int
+	Rounds      int
+	Question    game.Question
+	Answer      int // index of the right choice
+	Remaining   time.Duration
+	Counts      []int // picks per choice this round
+	Answered    int
+	Students    []game.ClassStudent // best score first
+	Flagship    int
+	FlagshipMax int
+}
+
+// Classroom is a live quiz session hosted on the teacher's machine. Students connect
+// over TCP with the room code; the session times every question itself so all students
+// get the same window, and the host only moves it on.
+type Classroom struct {
+	Code       string
+	Subject    string
+	Difficulty string
+	TimeLimit  time.Duration
+
+	ln        net.Listener
+	level     int
+	questions []game.Question
+
+	mu          sync.Mutex
+	students    []*classStudent
+	phase       ClassPhase
+	round       int
+	asked       time.Time
+	counts      []int
+	flagship    int
+	flagshipMax int
+	timer       *time.Timer
+}
+
+// classStudent is a connected student; messages to it are queued on out
+type classStudent struct {
+	game.ClassStudent
+	c        *conn
+	out      chan Message
+	answered bool
+	correct  bool
+}
+
+// queue sends m without blocking the session; a student too far behind is dropped
+func (s *classStudent) queue(m Message) {
+	select {
+	case s.out <- m:
+	default:
+		s.c.c.Close()
+	}
+}
+
+func (s *classStudent) writeLoop(c *conn, out chan Message) {
+	for m := range out {
+		if c.send(m) != nil {
+			c.c.Close()
+			return
+		}
+	}
+}
+
+// OpenClassroom starts listening for students on addr with a fresh room code
+func OpenClassroom(quiz *game.Quiz, subject, difficulty, addr string) (*Classroom, error) {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	code := make([]byte, 4)
+	for i := range code {
+		code[i] = roomAlphabet[rand.Intn(len(roomAlphabet))]
+	}
+	c := &Classroom{
+		Code:       string(code),
+		Subject:    subject,
+		Difficulty: difficulty,
+		TimeLimit:  game.QuestionTimeLimit,
+		ln:         ln,
+		level:      game.InitCombatState(difficulty).Level,
+		questions:  quiz.SelectQuestions([]string{subject}, difficulty, game.ClassQuestions),
+	}
+	go c.accept()
+	return c, nil
+}
+
+// Port is the TCP port students connect to
+func (c *Classroom) Port() int {
+	return c.ln.Addr().(*net.TCPAddr).Port
+}
+
+func (c *Classroom) accept() {
+	for {
+		nc, err := c.ln.Accept()
+		if err != nil {
+			return
+		}
+		go c.handle(nc)
+	}
+}
+
+// handle admits a student and reads their answers until they disconnect
+func (c *Classroom) handle(nc net.Conn) {
+	pc := newConn(nc)
+	m, err := pc.recv(HelloTimeout)
+	if err != nil || m.Type != MsgJoin {
+		nc.Close()
+		return
+	}
+	s, errMsg := c.join(pc, strings.TrimSpace(m.Name), strings.TrimSpace(m.Room))
+	if s == nil {
+		pc.send(Message{Type: MsgError, Error: errMsg})
+		nc.Close()
+		return
+	}
+	for {
+		m, err := pc.recv(0)
+		if err != nil {
+			break
+		}
+		if m.Type == MsgAnswer {
+			c.answer(s, m)
+		}
+	}
+	c.mu.Lock()
+	if s.c == pc {
+		s.Connected = false
+		close(s.out)
+		c.checkAllAnswered()
+	}
+	c.mu.Unlock()
+}
+
+// join adds a student, or reattaches one who dropped out under the same name
+func (c *Classroom) join(pc *conn, name, room string) (*classStudent, string) {
+	if !strings.EqualFold(room, c.Code) {
+		return nil, "wrong room code"
+	}
+	if name == "" {
+		return nil, "enter a name"
+	}
+	if len(name) > 16 {
+		name = name[:16]
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.phase == ClassOver {
+		return nil, "the session is over"
+	}
+	var s *classStudent
+	for _, st := range c.students {
+		if strings.EqualFold(st.Name, name) {
+			if st.Connected {
+				return nil, "that name is taken"
+			}
+			s = st
+		}
+	}
+	if s == nil {
+		ship := game.InitCombatState(c.Difficulty)
+		s = &classStudent{ClassStudent: game.ClassStudent{Name: name, HP: ship.PlayerHP, Shields: ship.PlayerShields}}
+		c.students = append(c.students, s)
+	}
+	s.c = pc
+	s.Connected = true
+	s.out = make(chan Message, 16)
+	go s.writeLoop(pc, s.out)
+	s.queue(Message{Type: MsgJoined, Name: c.Subject, Difficulty: c.Difficulty, Rounds: len(c.questions), Flagship: c.flagship, FlagshipMax: c.flagshipMax})
+	// Someone joining mid-question still gets what is left of it
+	if c.phase == ClassAsking && !s.answered {
+		s.queue(c.questionMsg())
+	}
+	return s, ""
+}
+
+// Start closes the lobby and asks the first question; it needs at least one student
+func (c *Classroom) Start() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.phase != ClassLobby || len(c.students) == 0 || len(c.questions) == 0 {
+		return false
+	}
+	c.flagshipMax = game.ClassFlagshipHP(c.level, len(c.students))
+	c.flagship = c.flagshipMax
+	c.ask()
+	return true
+}
+
+// Next moves from a revealed answer to the next question, or ends the session
+// after the last question or once the flagship is sunk
+func (c *Classroom) Next() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.phase != ClassReveal {
+		return
+	}
+	if c.round+1 >= len(c.questions) || c.flagship == 0 {
+		c.finish()
+		return
+	}
+	c.round++
+	c.ask()
+}
+
+// End finishes the session early
+func (c *Classroom) End() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.phase == ClassAsking {
+		c.reveal()
+	}
+	if c.phase != ClassOver {
+		c.finish()
+	}
+}
+
+// Close stops the session and disconnects everyone
+func (c *Classroom) Close() {
+	c.ln.Close()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.timer != nil {
+		c.timer.Stop()
+	}
+	for _, s := range c.students {
+		if s.Connected {
+			s.c.c.Close()
+		}
+	}
+}
+
+func (c *Classroom) questionMsg() Message {
+	q := c.questions[c.round]
+	left := c.TimeLimit - time.Since(c.asked)
+	return Message{Type: MsgQuestion, Round: c.round, Text: q.Text, Choices: q.Choices, TimeLimit: left.Milliseconds(), Flagship: c.flagship, FlagshipMax: c.flagshipMax}
+}
+
+// ask opens the current question for everyone; c.mu is held
+func (c *Classroom) ask() {
+	c.phase = ClassAsking
+	c.asked = time.Now()
+	c.counts = make([]int, len(c.questions[c.round].Choices))
+	for _, s := range c.students {
+		s.answered, s.correct = false, false
+		if s.Connected {
+			s.queue(c.questionMsg())
+		}
+	}
+	round := c.round
+	c.timer = time.AfterFunc(c.TimeLimit+AnswerGrace, func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		if c.phase == ClassAsking && c.round == round {
+			c.reveal()
+		}
+	})
+}
+
+func (c *Classroom) answer(s *classStudent, m Message) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.phase != ClassAsking || m.Round != c.round || s.answered || m.Choice < -1 || m.Choice >= len(c.counts) {
+		s.queue(Message{Type: MsgError, Error: "unexpected answer"})
+		return
+	}
+	elapsed := time.Since(c.asked)
+	s.answered = true
+	s.correct = m.Choice == c.questions[c.round].AnswerIndex() && elapsed <= c.TimeLimit
+	if elapsed > c.TimeLimit {
+		elapsed = c.TimeLimit
+	}
+	if m.Choice >= 0 {
+		c.counts[m.Choice]++
+	}
+	c.hit(game.AnswerStudent(&s.ClassStudent, c.level, s.correct, elapsed, c.TimeLimit))
+	c.checkAllAnswered()
+}
+
+func (c *Classroom) hit(damage int) {
+	c.flagship -= damage
+	if c.flagship < 0 {
+		c.flagship = 0
+	}
+}
+
+// checkAllAnswered reveals the answer early once every connected student answered; c.mu is held
+func (c *Classroom) checkAllAnswered() {
+	if c.phase != ClassAsking {
+		return
+	}
+	for _, s := range c.students {
+		if s.Connected && !s.answered {
+			return
+		}
+	}
+	c.reveal()
+}
+
+// reveal closes the question and tells every student how they did; c.mu is held
+func (c *Classroom) reveal() {
+	c.timer.Stop()
+	for _, s := range c.students {
+		if !s.answered {
+			s.answered = true
+			game.AnswerStudent(&s.ClassStudent, c.level, false, c.TimeLimit, c.TimeLimit)
+		}
+	}
+	c.phase = ClassReveal
+	answer := c.questions[c.round].AnswerIndex()
+	for _, s := range c.students {
+		if s.Connected {
+			s.queue(Message{Type: MsgResult, Round: c.round, Answer: answer, Correct: []bool{s.correct}, Players: []PlayerState{playerState(s)}, Flagship: c.flagship, FlagshipMax: c.flagshipMax, Rank: c.rank(s)})
+		}
+	}
+}
+
+// finish ends the session; c.mu is held
+func (c *Classroom) finish() {
+	c.phase = ClassOver
+	reason := "The flagship escaped."
+	if c.flagship == 0 {
+		reason = "The class sank the flagship!"
+	}
+	for _, s := range c.students {
+		if s.Connected {
+			s.queue(Message{Type: MsgOver, Reason: reason, Players: []PlayerState{playerState(s)}, Flagship: c.flagship, FlagshipMax: c.flagshipMax, Rank: c.rank(s)})
+		}
+	}
+}
+
+// rank is the student's place in class by score, starting at 1
+func (c *Classroom) rank(s *classStudent) int {
+	r := 1
+	for _, o := range c.students {
+		if o.Score > s.Score {
+			r++
+		}
+	}
+	return r
+}
+
+func playerState(s *classStudent) PlayerState {
+	return PlayerState{Name: s.Name, HP: s.HP, Shields: s.Shields, Score: s.Score, Correct: s.Correct}
+}
+
+// standings returns the students, best score first; c.mu is held
+func (c *Classroom) standings() []game.ClassStudent {
+	out := make([]game.ClassStudent, len(c.students))
+	for i, s := range c.students {
+		out[i] = s.ClassStudent
+	}
+	sort.SliceStable(out, func(a, b int) bool { return out[a].Score > out[b].Score })
+	return out
+}
+
+// View returns the state of the session for the host screen
+func (c *Classroom) View() ClassView {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	v := ClassView{
+		Code:        c.Code,
+		Phase:       c.phase,
+		Round:       c.round,
+		Rounds:      len(c.questions),
+		Counts:      append([]int(nil), c.counts...),
+		Students:    c.standings(),
+		Flagship:    c.flagship,
+		FlagshipMax: c.flagshipMax,
+	}
+	if c.phase == ClassAsking || c.phase == ClassReveal {
+		v.Question = c.questions[c.round]
+		v.Answer = c.questions[c.round].AnswerIndex()
+		for _, s := range c.students {
+			if s.answered {
+				v.Answered++
+			}
+		}
+	}
+	if c.phase == ClassAsking {
+		v.Remaining = c.TimeLimit - time.Since(c.asked)
+		if v.Remaining < 0 {
+			v.Remaining = 0
+		}
+	}
+	return v
+}
+
+// Report summarizes the session for saving
+func (c *Classroom) Report() game.ClassReport {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	asked := 0
+	if c.phase != ClassLobby {
+		asked = c.round + 1
+	}
+	return game.ClassReport{
+		RoomCode:     c.Code,
+		Subject:      c.Subject,
+		Difficulty:   c.Difficulty,
+		Questions:    asked,
+		FlagshipSunk: c.flagshipMax > 0 && c.flagship == 0,
+		Students:     c.standings(),
+	}
+}
+
+// LocalAddrs lists the IPv4 addresses of this machine that students can connect to
+func LocalAddrs() []string {
+	var out []string
+	addrs, err := net.InterfaceAddrs()
+	if err != nil {
+		return nil
+	}
+	for _, a := range addrs {
+		if ipn, ok := a.(*net.IPNet); ok && !ipn.IP.IsLoopback() && ipn.IP.To4() != nil {
+			out = append(out, ipn.IP.String())
+		}
+	}
+	return out
+}
@@ -0,0 +1,96 @@
+package netplay
+
+import (
+	"net"
+	"sync"
+)
+
+// Client is a connection to a match server. Messages are read in the background
+// so a game loop can poll them every frame.
+type Client struct {
+	c    *conn
+	msgs chan Message
+
+	mu  sync.Mutex
+	err error
+}
+
+// Dial connects to the server at addr and joins its lobby as name
+func Dial(addr, name string) (*Client, error) {
+	return dial(addr, Message{Type: MsgHello, Name: name})
+}
+
+// DialFleet connects to the fleet server at addr and joins its lobby as name,
+// with a ship asked questions of subject
+func DialFleet(addr, name, subject string) (*Client, error) {
+	return dial(addr, Message{Type: MsgHello, Name: name, Subject: subject})
+}
+
+// JoinClass connects to the classroom session at addr with its room code
+func JoinClass(addr, room, name string) (*Client, error) {
+	return dial(addr, Message{Type: MsgJoin, Name: name, Room: room})
+}
+
+func dial(addr string, hello Message) (*Client, error) {
+	nc, err := net.DialTimeout("tcp", addr, DialTimeout)
+	if err != nil {
+		return nil, err
+	}
+	c := &Client{c: newConn(nc), msgs: make(chan Message, 16)}
+	if err := c.c.send(hello); err != nil {
+		nc.Close()
+		return nil, err
+	}
+	go c.readLoop()
+	return c, nil
+}
+
+func (c *Client) readLoop() {
+	defer close(c.msgs)
+	for {
+		m, err := c.c.recv(0)
+		if err != nil {
+			c.mu.Lock()
+			c.err = err
+			c.mu.Unlock()
+			return
+		}
+		c.msgs <- m
+	}
+}
+
+// Poll returns the next message if one has arrived
+func (c *Client) Poll() (Message, bool) {
+	select {
+	case m, ok := <-c.msgs:
+		return m, ok
+	default:
+		return Message{}, false
+	}
+}
+
+// Next waits for the next message; it fails once the connection is gone
+func (c *Client) Next() (Message, error) {
+	m, ok := <-c.msgs
+	if !ok {
+		return Message{}, c.Err()
+	}
+	return m, nil
+}
+
+// Answer sends the choice for a round; -1 gives up on the question
+func (c *Client) Answer(round, choice int) error {
+	return c.c.send(Message{Type: MsgAnswer, Round: round, Choice: choice})
+}
+
+// Err returns why the connection was lost, or nil while it is up
+func (c *Client) Err() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.err
+}
+
+// Close disconnects from the server
+func (c *Client) Close() error {
+	return c.c.c.Close()
+}
@@ -0,0 +1,258 @@
+package netplay
+
+import (
+	"log"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/RALPH22222/Broadside/game"
+)
+
+// FleetServer gathers clients into co-op fleets of two to four ships against one
+// enemy and referees their battles with the fleet rules of the game package. A
+// fleet sails once it is full, or Gather after its second ship joined.
+//
+// Ships take turns as in a hot-seat fleet: every client sees each question, and
+// only the ship whose turn it is may answer it. A captain who disconnects has
+// their ship sunk while the rest of the fleet fights on.
+type FleetServer struct {
+	Quiz        *game.Quiz
+	Difficulty  string
+	TimeLimit   time.Duration // per question
+	ResultPause time.Duration // time the clients show a turn's result
+	Gather      time.Duration
+	Store       game.Store // saves finished battles; nil records nothing
+
+	mu    sync.Mutex
+	lobby []*player
+	fleet int // counts the fleets sailed, so a stale gather timer leaves the next lobby be
+}
+
+// NewFleetServer returns a server asking questions of the given difficulty
+func NewFleetServer(quiz *game.Quiz, difficulty string) *FleetServer {
+	return &FleetServer{Quiz: quiz, Difficulty: difficulty, TimeLimit: game.QuestionTimeLimit, ResultPause: ResultPause, Gather: FleetGather}
+}
+
+// ListenAndServe listens on addr and serves fleet battles until the listener fails
+func (s *FleetServer) ListenAndServe(addr string) error {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	log.Printf("fleet server listening on %s (%s)", ln.Addr(), s.Difficulty)
+	return s.Serve(ln)
+}
+
+// Serve accepts clients on ln; each fleet fights in its own goroutine
+func (s *FleetServer) Serve(ln net.Listener) error {
+	return serve(ln, s.join)
+}
+
+// subject returns the quiz's spelling of a subject with questions at the server's
+// difficulty, or "" if there is none
+func (s *FleetServer) subject(name string) string {
+	for _, subject := range s.Quiz.ListSubjects() {
+		if strings.EqualFold(subject, name) && len(s.Quiz.SelectQuestions([]string{subject}, s.Difficulty, 1)) > 0 {
+			return subject
+		}
+	}
+	return ""
+}
+
+// join puts p in the lobby and tells everyone there who is aboard
+func (s *FleetServer) join(p *player) {
+	if p.subject = s.subject(p.subject); p.subject == "" {
+		p.c.send(Message{Type: MsgError, Error: "pick a subject of the quiz"})
+		p.c.c.Close()
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	lobby := s.lobby[:0]
+	for _, w := range s.lobby {
+		if w.alive() {
+			lobby = append(lobby, w)
+		}
+	}
+	s.lobby = append(lobby, p)
+	waiting := Message{Type: MsgWaiting, Players: lobbyStates(s.lobby)}
+	for _, w := range s.lobby {
+		w.c.send(waiting)
+	}
+	switch len(s.lobby) {
+	case game.FleetMaxShips:
+		s.sail()
+	case game.FleetMinShips:
+		fleet := s.fleet
+		time.AfterFunc(s.Gather, func() {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			if s.fleet == fleet {
+				s.sail()
+			}
+		})
+	}
+}
+
+// sail starts a battle for the captains in the lobby still connected; s.mu is held
+func (s *FleetServer) sail() {
+	var ships []*player
+	for _, p := range s.lobby {
+		if p.alive() {
+			ships = append(ships, p)
+		}
+	}
+	if len(ships) < game.FleetMinShips {
+		// Wait for the next captain to start the gather timer again
+		s.lobby = ships
+		s.fleet++
+		return
+	}
+	s.lobby = nil
+	s.fleet++
+	go s.runFleet(ships)
+}
+
+func lobbyStates(players []*player) []PlayerState {
+	out := make([]PlayerState, len(players))
+	for i, p := range players {
+		out[i] = PlayerState{Name: p.name}
+	}
+	return out
+}
+
+// runFleet referees one battle. Each ship draws its questions from its own subject.
+func (s *FleetServer) runFleet(players []*player) {
+	for _, p := range players {
+		defer p.c.c.Close()
+	}
+	names := make([]string, len(players))
+	subjects := make([]string, len(players))
+	for i, p := range players {
+		names[i], subjects[i] = p.name, p.subject
+	}
+	f := game.NewFleet(names, make([]int64, len(players)), subjects, s.Difficulty)
+	questions := make([][]game.Question, len(players))
+	for i, subject := range subjects {
+		questions[i] = s.Quiz.SelectQuestions([]string{subject}, s.Difficulty, f.Questions)
+	}
+	log.Printf("fleet sailed: %s", strings.Join(names, ", "))
+
+	for i, p := range players {
+		start := Message{Type: MsgStart, You: i, Difficulty: s.Difficulty, Rounds: f.Questions, Players: fleetStates(f),
+			Flagship: f.EnemyHP, FlagshipMax: f.EnemyMaxHP}
+		if p.c.send(start) != nil {
+			f.Sink(i)
+		}
+	}
+	for round := 0; !f.Over(); round++ {
+		turn := f.Turn
+		if !players[turn].alive() {
+			log.Printf("fleet: %s disconnected", players[turn].name)
+			f.Sink(turn)
+			continue
+		}
+		own := questions[turn]
+		s.playTurn(f, players, round, own[f.Ships[turn].Answered%len(own)])
+	}
+	s.finish(f, players)
+}
+
+// playTurn asks the ship whose turn it is q, shows it to the rest of the fleet and
+// applies the answer, a miss if none comes in time
+func (s *FleetServer) playTurn(f *game.Fleet, players []*player, round int, q game.Question) {
+	turn := f.Turn
+	answer := q.AnswerIndex()
+	ask := Message{Type: MsgQuestion, Round: round, Turn: turn, Text: q.Text, Choices: q.Choices, TimeLimit: s.TimeLimit.Milliseconds()}
+	broadcast(players, ask)
+	asked := time.Now()
+	deadline := time.NewTimer(s.TimeLimit + AnswerGrace)
+	defer deadline.Stop()
+
+	p := players[turn]
+	for {
+		select {
+		case msg := <-p.msgs:
+			// Answers to earlier turns were queued while another ship answered
+			if msg.Type != MsgAnswer || msg.Round != round || msg.Choice < -1 || msg.Choice >= len(q.Choices) {
+				p.c.send(Message{Type: MsgError, Error: "unexpected answer"})
+				continue
+			}
+			elapsed := time.Since(asked)
+			correct := msg.Choice == answer && elapsed <= s.TimeLimit
+			f.Answer(correct, false, elapsed, s.TimeLimit, false)
+			s.result(f, players, round, turn, answer, correct)
+		case <-deadline.C:
+			f.Answer(false, true, s.TimeLimit, s.TimeLimit, false)
+			s.result(f, players, round, turn, answer, false)
+		case <-p.gone:
+			log.Printf("fleet: %s disconnected", p.name)
+			f.Sink(turn)
+		}
+		return
+	}
+}
+
+// result tells the fleet how a turn went and gives the clients time to show it
+func (s *FleetServer) result(f *game.Fleet, players []*player, round, turn, answer int, correct bool) {
+	hits := make([]bool, len(players))
+	hits[turn] = correct
+	broadcast(players, Message{Type: MsgResult, Round: round, Turn: turn, Answer: answer, Correct: hits, Players: fleetStates(f),
+		Flagship: f.EnemyHP, FlagshipMax: f.EnemyMaxHP})
+	time.Sleep(s.ResultPause)
+}
+
+// finish tells the fleet the outcome and records the battle
+func (s *FleetServer) finish(f *game.Fleet, players []*player) {
+	reason := "all questions answered"
+	switch {
+	case f.Victory():
+		reason = "enemy sunk"
+	case fleetSunk(f):
+		reason = "fleet sunk"
+	}
+	broadcast(players, Message{Type: MsgOver, Reason: reason, Players: fleetStates(f), Flagship: f.EnemyHP, FlagshipMax: f.EnemyMaxHP})
+	log.Printf("fleet battle over: %s", reason)
+	if s.Store == nil {
+		return
+	}
+	for i, p := range players {
+		id, err := game.SeatAccount(s.Store, p.name)
+		if err != nil {
+			log.Printf("failed to save user: %v", err)
+			return
+		}
+		f.Ships[i].UserID = id
+	}
+	if err := s.Store.InsertFleetResult(f, s.Difficulty); err != nil {
+		log.Printf("failed to save fleet result: %v", err)
+	}
+}
+
+func fleetSunk(f *game.Fleet) bool {
+	for _, ship := range f.Ships {
+		if ship.HP > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// broadcast sends m to every player still connected
+func broadcast(players []*player, m Message) {
+	for _, p := range players {
+		if p.alive() {
+			p.c.send(m)
+		}
+	}
+}
+
+func fleetStates(f *game.Fleet) []PlayerState {
+	out := make([]PlayerState, len(f.Ships))
+	for i, ship := range f.Ships {
+		out[i] = PlayerState{Name: ship.Name, HP: ship.HP, Shields: ship.Shields, Score: ship.Score, Correct: ship.Correct}
+	}
+	return out
+}
@@ -0,0 +1,153 @@
+package netplay
+
+import (
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/RALPH22222/Broadside/game"
+)
+
+// startFleetServer serves fleet battles on a loopback port and returns its address
+func startFleetServer(t *testing.T, gather time.Duration) string {
+	var questions []game.Question
+	for _, subject := range []string{"Math", "Science"} {
+		for i := 0; i < 5; i++ {
+			questions = append(questions, game.Question{Text: subject + " " + strconv.Itoa(i), Choices: []string{"Bow", "Stern", "Port"},
+				Answer: "Stern", Subject: subject, Difficulty: "Easy"})
+		}
+	}
+	s := NewFleetServer(&game.Quiz{Questions: questions}, "Easy")
+	s.TimeLimit = 2 * time.Second
+	s.ResultPause = 0
+	s.Gather = gather
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	go s.Serve(ln)
+	return ln.Addr().String()
+}
+
+// sailFleet connects a captain per subject and waits for their battle to start
+func sailFleet(t *testing.T, addr string, subjects ...string) []*Client {
+	var fleet []*Client
+	for i, subject := range subjects {
+		c, err := DialFleet(addr, "Captain "+strconv.Itoa(i), subject)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { c.Close() })
+		if w := expect(t, c, MsgWaiting); len(w.Players) != i+1 {
+			t.Fatalf("lobby = %+v, want %d captains", w.Players, i+1)
+		}
+		fleet = append(fleet, c)
+	}
+	for i, c := range fleet {
+		// Earlier captains hear of everyone who joined after them first
+		start := expect(t, c, MsgWaiting, MsgStart)
+		for start.Type == MsgWaiting {
+			start = expect(t, c, MsgWaiting, MsgStart)
+		}
+		if start.You != i || len(start.Players) != len(subjects) || start.Flagship == 0 || start.Flagship != start.FlagshipMax {
+			t.Fatalf("start for captain %d = %+v", i, start)
+		}
+	}
+	return fleet
+}
+
+func TestFleetBattle(t *testing.T) {
+	cases := []struct {
+		name     string
+		gather   time.Duration
+		subjects []string
+	}{
+		{"two ships", 100 * time.Millisecond, []string{"Math", "science"}},
+		// A full fleet sails without waiting out the gather time
+		{"full fleet", time.Minute, []string{"Math", "Math", "Science", "Science"}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			fleet := sailFleet(t, startFleetServer(t, c.gather), c.subjects...)
+			turns := 0
+			for {
+				q := expect(t, fleet[0], MsgQuestion, MsgOver)
+				if q.Type == MsgOver {
+					if q.Reason != "enemy sunk" || q.Flagship != 0 {
+						t.Errorf("over = %+v, want the enemy sunk", q)
+					}
+					for _, ship := range q.Players {
+						if ship.HP == 0 || ship.Correct == 0 {
+							t.Errorf("ship after the battle = %+v", ship)
+						}
+					}
+					break
+				}
+				for _, other := range fleet[1:] {
+					expect(t, other, MsgQuestion)
+				}
+				// Only the ship whose turn it is answers, always rightly
+				if err := fleet[q.Turn].Answer(q.Round, 1); err != nil {
+					t.Fatal(err)
+				}
+				for _, other := range fleet {
+					r := expect(t, other, MsgResult)
+					if r.Turn != q.Turn || r.Answer != 1 || !r.Correct[q.Turn] {
+						t.Fatalf("turn %d result = %+v", turns, r)
+					}
+				}
+				turns++
+				if turns > len(fleet)*game.GetMainQuestionsCount(1) {
+					t.Fatal("the battle outlasted its questions")
+				}
+			}
+		})
+	}
+}
+
+func TestFleetDisconnectSinks(t *testing.T) {
+	fleet := sailFleet(t, startFleetServer(t, 500*time.Millisecond), "Math", "Science", "Math")
+	q := expect(t, fleet[0], MsgQuestion)
+	expect(t, fleet[1], MsgQuestion)
+	expect(t, fleet[2], MsgQuestion)
+	if q.Turn != 0 {
+		t.Fatalf("first turn is ship %d", q.Turn)
+	}
+	fleet[1].Close()
+	if err := fleet[0].Answer(q.Round, 1); err != nil {
+		t.Fatal(err)
+	}
+	expect(t, fleet[0], MsgResult)
+	expect(t, fleet[2], MsgResult)
+	// The captain who left loses their turn and their ship, though the server may
+	// ask them once before it notices they are gone
+	next := expect(t, fleet[0], MsgQuestion)
+	expect(t, fleet[2], MsgQuestion)
+	if next.Turn == 1 {
+		next = expect(t, fleet[0], MsgQuestion)
+		expect(t, fleet[2], MsgQuestion)
+	}
+	if next.Turn != 2 {
+		t.Fatalf("next turn is ship %d, want 2", next.Turn)
+	}
+	if err := fleet[2].Answer(next.Round, 0); err != nil {
+		t.Fatal(err)
+	}
+	r := expect(t, fleet[0], MsgResult)
+	if r.Players[1].HP != 0 || r.Correct[2] {
+		t.Errorf("result = %+v, want ship 1 sunk and ship 2's miss", r)
+	}
+}
+
+func TestFleetUnknownSubject(t *testing.T) {
+	c, err := DialFleet(startFleetServer(t, time.Minute), "Ana", "Basket Weaving")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	if m := expect(t, c, MsgError); m.Error == "" {
+		t.Errorf("error = %+v", m)
+	}
+}
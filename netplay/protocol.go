@@ -0,0 +1,130 @@
+// Package netplay runs 1v1 battles between two machines on the local network.
+// The server is authoritative: it picks the questions, times the answers and
+// applies the versus rules of the game package; clients only show what it sends.
+//
+// Messages are JSON objects, one per line, over plain TCP.
+package netplay
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net"
+	"sync"
+	"time"
+)
+
+// DefaultAddr is the address `broadside serve` listens on
+const DefaultAddr = ":7777"
+
+// Timeouts of the protocol
+const (
+	DialTimeout  = 3 * time.Second
+	HelloTimeout = 10 * time.Second        // a new connection must say hello in time
+	WriteTimeout = 5 * time.Second         // a client that stops reading is dropped
+	AnswerGrace  = 1500 * time.Millisecond // network slack on top of the question timer
+	ResultPause  = 2 * time.Second         // time the clients show a round's result
+	FleetGather  = 20 * time.Second        // a fleet with two ships waits this long for more
+)
+
+// Message types
+const (
+	MsgHello    = "hello"    // client: join with a name, and a subject for a fleet
+	MsgWaiting  = "waiting"  // server: waiting for an opponent
+	MsgStart    = "start"    // server: match found
+	MsgQuestion = "question" // server: next question
+	MsgAnswer   = "answer"   // client: answer to a question
+	MsgResult   = "result"   // server: outcome of a round
+	MsgOver     = "over"     // server: match finished
+	MsgError    = "error"    // server: the last message was rejected
+
+	// Classroom sessions
+	MsgJoin   = "join"   // student: join a room with a name and the room code
+	MsgJoined = "joined" // server: the student is in the room
+)
+
+// PlayerState is a player's ship and score as the server sees them
+type PlayerState struct {
+	Name    string `json:"name"`
+	HP      int    `json:"hp"`
+	Shields int    `json:"shields"`
+	Score   int    `json:"score"`
+	Correct int    `json:"correct"`
+}
+
+// Message is the single envelope of every message; fields not used by a type stay empty
+type Message struct {
+	Type string `json:"type"`
+
+	// hello and join; a fleet hello also names the captain's subject
+	Name    string `json:"name,omitempty"`
+	Room    string `json:"room,omitempty"`
+	Subject string `json:"subject,omitempty"`
+
+	// start: you is the player index of the receiver
+	You        int    `json:"you,omitempty"`
+	Difficulty string `json:"difficulty,omitempty"`
+	Rounds     int    `json:"rounds,omitempty"`
+
+	// question and answer; in a fleet battle Turn is the ship answering
+	Round     int      `json:"round,omitempty"`
+	Turn      int      `json:"turn,omitempty"`
+	Text      string   `json:"text,omitempty"`
+	Choices   []string `json:"choices,omitempty"`
+	TimeLimit int64    `json:"time_limit_ms,omitempty"`
+	Choice    int      `json:"choice,omitempty"`
+
+	// result and over
+	Answer  int           `json:"answer,omitempty"`
+	Correct []bool        `json:"correct,omitempty"` // per player
+	Players []PlayerState `json:"players,omitempty"`
+	Winner  int           `json:"winner,omitempty"` // -1 for a draw
+	Reason  string        `json:"reason,omitempty"`
+	Error   string        `json:"error,omitempty"`
+
+	// classroom and fleet result and over: the shared enemy's hull, and the
+	// student's place in class
+	Flagship    int `json:"flagship,omitempty"`
+	FlagshipMax int `json:"flagship_max,omitempty"`
+	Rank        int `json:"rank,omitempty"`
+}
+
+// conn wraps a TCP connection with line-delimited JSON encoding
+type conn struct {
+	c   net.Conn
+	r   *bufio.Scanner
+	wmu sync.Mutex // one message is written at a time
+	enc *json.Encoder
+}
+
+func newConn(c net.Conn) *conn {
+	r := bufio.NewScanner(c)
+	r.Buffer(make([]byte, 0, 4096), 64*1024)
+	return &conn{c: c, r: r, enc: json.NewEncoder(c)}
+}
+
+// send writes one message, failing if the peer doesn't take it in time
+func (c *conn) send(m Message) error {
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+	c.c.SetWriteDeadline(time.Now().Add(WriteTimeout))
+	return c.enc.Encode(m)
+}
+
+// recv reads one message; a zero timeout waits forever
+func (c *conn) recv(timeout time.Duration) (Message, error) {
+	var deadline time.Time
+	if timeout > 0 {
+		deadline = time.Now().Add(timeout)
+	}
+	c.c.SetReadDeadline(deadline)
+	if !c.r.Scan() {
+		if err := c.r.Err(); err != nil {
+			return Message{}, err
+		}
+		return Message{}, io.EOF
+	}
+	var m Message
+	err := json.Unmarshal(c.r.Bytes(), &m)
+	return m, err
+}
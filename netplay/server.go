@@ -0,0 +1,276 @@
+package netplay
+
+import (
+	"log"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/RALPH22222/Broadside/game"
+)
+
+// Server pairs clients in the order they connect and referees their matches
+type Server struct {
+	Quiz        *game.Quiz
+	Difficulty  string
+	TimeLimit   time.Duration // per question
+	ResultPause time.Duration // time the clients show a round's result
+	Store       game.Store    // saves finished matches; nil records nothing
+
+	mu      sync.Mutex
+	waiting *player
+}
+
+// NewServer returns a server asking questions of the given difficulty
+func NewServer(quiz *game.Quiz, difficulty string) *Server {
+	return &Server{Quiz: quiz, Difficulty: difficulty, TimeLimit: game.QuestionTimeLimit, ResultPause: ResultPause}
+}
+
+// ListenAndServe listens on addr and serves matches until the listener fails
+func (s *Server) ListenAndServe(addr string) error {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	log.Printf("match server listening on %s (%s)", ln.Addr(), s.Difficulty)
+	return s.Serve(ln)
+}
+
+// Serve accepts clients on ln; each pair of clients plays a match in its own goroutine
+func (s *Server) Serve(ln net.Listener) error {
+	return serve(ln, s.join)
+}
+
+// serve accepts clients on ln and hands each one to join once it said hello
+func serve(ln net.Listener, join func(*player)) error {
+	for {
+		c, err := ln.Accept()
+		if err != nil {
+			return err
+		}
+		go func() {
+			if p := handshake(c); p != nil {
+				join(p)
+			}
+		}()
+	}
+}
+
+// player is a connected client; its messages arrive on msgs until gone is closed
+type player struct {
+	name    string
+	subject string // picked in the hello of a fleet battle
+	c       *conn
+	msgs    chan Message
+	gone    chan struct{}
+}
+
+func (p *player) readLoop() {
+	defer close(p.gone)
+	for {
+		m, err := p.c.recv(0)
+		if err != nil {
+			return
+		}
+		select {
+		case p.msgs <- m:
+		default: // a client flooding the server loses messages, not the match
+		}
+	}
+}
+
+func (p *player) alive() bool {
+	select {
+	case <-p.gone:
+		return false
+	default:
+		return true
+	}
+}
+
+// handshake waits for the client's hello; nil if it never came
+func handshake(c net.Conn) *player {
+	pc := newConn(c)
+	m, err := pc.recv(HelloTimeout)
+	name := strings.TrimSpace(m.Name)
+	if err != nil || m.Type != MsgHello || name == "" {
+		pc.send(Message{Type: MsgError, Error: "expected hello with a name"})
+		c.Close()
+		return nil
+	}
+	if len(name) > 16 {
+		name = name[:16]
+	}
+	p := &player{name: name, subject: strings.TrimSpace(m.Subject), c: pc, msgs: make(chan Message, 8), gone: make(chan struct{})}
+	go p.readLoop()
+	return p
+}
+
+// join pairs p with the waiting client, or makes p wait for the next one
+func (s *Server) join(p *player) {
+	s.mu.Lock()
+	w := s.waiting
+	if w != nil && !w.alive() {
+		w = nil
+	}
+	if w == nil {
+		// Tell p before it can be paired, so waiting never arrives after start
+		defer s.mu.Unlock()
+		if err := p.c.send(Message{Type: MsgWaiting}); err != nil {
+			p.c.c.Close()
+			return
+		}
+		s.waiting = p
+		return
+	}
+	s.waiting = nil
+	s.mu.Unlock()
+	go s.runMatch([2]*player{w, p})
+}
+
+// runMatch referees one match. Both players get the same question at once and the
+// answers are applied in the order they arrive, so a faster hit lands first.
+// A player who disconnects forfeits: their ship counts as sunk.
+func (s *Server) runMatch(players [2]*player) {
+	defer players[0].c.c.Close()
+	defer players[1].c.c.Close()
+	level := game.InitCombatState(s.Difficulty).Level
+	m := game.NewVersusMatch([2]string{players[0].name, players[1].name}, [2]int64{}, level)
+	questions := s.Quiz.SelectQuestions(s.Quiz.ListSubjects(), s.Difficulty, game.VersusRounds)
+	log.Printf("match started: %s vs %s", players[0].name, players[1].name)
+
+	for i, p := range players {
+		start := Message{Type: MsgStart, You: i, Name: players[1-i].name, Difficulty: s.Difficulty, Rounds: len(questions), Players: states(m)}
+		if p.c.send(start) != nil {
+			s.forfeit(m, players, i)
+			return
+		}
+	}
+	for round, q := range questions {
+		if m.Over() {
+			break
+		}
+		if quit := s.playRound(m, players, round, q); quit >= 0 {
+			s.forfeit(m, players, quit)
+			return
+		}
+	}
+	reason := "all rounds played"
+	if m.Players[0].HP == 0 || m.Players[1].HP == 0 {
+		reason = "ship sunk"
+	}
+	s.finish(m, players, reason)
+}
+
+// playRound asks q and applies both answers; it returns the player who dropped out, or -1.
+func (s *Server) playRound(m *game.VersusMatch, players [2]*player, round int, q game.Question) int {
+	answer := q.AnswerIndex()
+	ask := Message{Type: MsgQuestion, Round: round, Text: q.Text, Choices: q.Choices, TimeLimit: s.TimeLimit.Milliseconds()}
+	for i, p := range players {
+		if p.c.send(ask) != nil {
+			return i
+		}
+	}
+	asked := time.Now()
+	deadline := time.NewTimer(s.TimeLimit + AnswerGrace)
+	defer deadline.Stop()
+
+	var answered [2]bool
+	correct := make([]bool, 2)
+	for !answered[0] || !answered[1] {
+		var from int
+		var msg Message
+		select {
+		case msg = <-players[0].msgs:
+		case msg = <-players[1].msgs:
+			from = 1
+		case <-players[0].gone:
+			return 0
+		case <-players[1].gone:
+			return 1
+		case <-deadline.C:
+			// Whoever hasn't answered timed out
+			for i := range answered {
+				if !answered[i] && !m.Over() {
+					m.Turn = i
+					m.Answer(false, s.TimeLimit, s.TimeLimit, false)
+				}
+				answered[i] = true
+			}
+			continue
+		}
+		if msg.Type != MsgAnswer || msg.Round != round || answered[from] || msg.Choice < -1 || msg.Choice >= len(q.Choices) {
+			players[from].c.send(Message{Type: MsgError, Error: "unexpected answer"})
+			continue
+		}
+		answered[from] = true
+		correct[from] = msg.Choice == answer
+		// Answers after the timer ran out still count as a miss
+		elapsed := time.Since(asked)
+		if elapsed > s.TimeLimit {
+			correct[from] = false
+		}
+		if !m.Over() {
+			m.Turn = from
+			m.Answer(correct[from], elapsed, s.TimeLimit, false)
+		}
+	}
+
+	result := Message{Type: MsgResult, Round: round, Answer: answer, Correct: correct, Players: states(m)}
+	for i, p := range players {
+		if p.c.send(result) != nil {
+			return i
+		}
+	}
+	// Give the clients time to show the result, still watching for dropouts
+	pause := time.NewTimer(s.ResultPause)
+	defer pause.Stop()
+	select {
+	case <-pause.C:
+	case <-players[0].gone:
+		return 0
+	case <-players[1].gone:
+		return 1
+	}
+	return -1
+}
+
+// forfeit ends the match after player quit dropped out
+func (s *Server) forfeit(m *game.VersusMatch, players [2]*player, quit int) {
+	m.Players[quit].HP = 0
+	s.finish(m, players, players[quit].name+" disconnected")
+}
+
+// finish tells both players the outcome and records the match
+func (s *Server) finish(m *game.VersusMatch, players [2]*player, reason string) {
+	over := Message{Type: MsgOver, Winner: m.Winner(), Reason: reason, Players: states(m)}
+	for _, p := range players {
+		if p.alive() {
+			p.c.send(over)
+		}
+	}
+	log.Printf("match over: %s vs %s, %s", players[0].name, players[1].name, reason)
+	if s.Store == nil {
+		return
+	}
+	for i, p := range players {
+		id, err := game.SeatAccount(s.Store, p.name)
+		if err != nil {
+			log.Printf("failed to save user: %v", err)
+			return
+		}
+		m.Players[i].UserID = id
+	}
+	if err := s.Store.InsertVersusMatch(m, s.Difficulty); err != nil {
+		log.Printf("failed to save versus match: %v", err)
+	}
+}
+
+func states(m *game.VersusMatch) []PlayerState {
+	out := make([]PlayerState, len(m.Players))
+	for i, p := range m.Players {
+		out[i] = PlayerState{Name: p.Name, HP: p.HP, Shields: p.Shields, Score: p.Score, Correct: p.Correct}
+	}
+	return out
+}
@@ -0,0 +1,147 @@
+package netplay
+
+import (
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/RALPH22222/Broadside/game"
+)
+
+// startServer serves matches on a loopback port and returns its address
+func startServer(t *testing.T, timeLimit time.Duration) string {
+	// Two choices differ only in capitals, and only one of them is right
+	var questions []game.Question
+	for i := 0; i < game.VersusRounds; i++ {
+		questions = append(questions, game.Question{Text: "Question " + strconv.Itoa(i), Choices: []string{"starboard", "Starboard", "Stern"},
+			Answer: "Starboard", Subject: "Math", Difficulty: "Easy"})
+	}
+	s := NewServer(&game.Quiz{Questions: questions}, "Easy")
+	s.TimeLimit = timeLimit
+	s.ResultPause = 0
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	go s.Serve(ln)
+	return ln.Addr().String()
+}
+
+func dialTest(t *testing.T, addr, name string) *Client {
+	c, err := Dial(addr, name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { c.Close() })
+	return c
+}
+
+// expect waits for the next message from the server and checks its type
+func expect(t *testing.T, c *Client, types ...string) Message {
+	t.Helper()
+	select {
+	case m, ok := <-c.msgs:
+		if !ok {
+			t.Fatalf("connection lost: %v", c.Err())
+		}
+		for _, typ := range types {
+			if m.Type == typ {
+				return m
+			}
+		}
+		t.Fatalf("got %+v, want one of %v", m, types)
+	case <-time.After(5 * time.Second):
+		t.Fatalf("no message, want one of %v", types)
+	}
+	return Message{}
+}
+
+// pair connects two captains and waits for their match to start
+func pair(t *testing.T, addr string) (ana, ben *Client) {
+	ana = dialTest(t, addr, "Ana")
+	expect(t, ana, MsgWaiting)
+	ben = dialTest(t, addr, "Ben")
+	for i, c := range []*Client{ana, ben} {
+		start := expect(t, c, MsgStart)
+		if start.You != i || start.Rounds != game.VersusRounds {
+			t.Fatalf("start for player %d = %+v", i, start)
+		}
+	}
+	return ana, ben
+}
+
+func TestMatch(t *testing.T) {
+	ana, ben := pair(t, startServer(t, 2*time.Second))
+	rounds := 0
+	for {
+		q := expect(t, ana, MsgQuestion, MsgOver)
+		if q.Type == MsgOver {
+			expect(t, ben, MsgOver)
+			if q.Winner != 0 || q.Players[0].HP != game.VersusMaxHP || q.Players[1].HP >= game.VersusMaxHP {
+				t.Errorf("over = %+v, want Ana to win unhurt", q)
+			}
+			if q.Players[0].Correct != rounds || q.Players[1].Correct != 0 {
+				t.Errorf("correct answers = %d and %d after %d rounds", q.Players[0].Correct, q.Players[1].Correct, rounds)
+			}
+			break
+		}
+		expect(t, ben, MsgQuestion)
+		// Ana always picks the right choice, Ben never does
+		if err := ana.Answer(q.Round, 1); err != nil {
+			t.Fatal(err)
+		}
+		if err := ben.Answer(q.Round, 0); err != nil {
+			t.Fatal(err)
+		}
+		for _, c := range []*Client{ana, ben} {
+			r := expect(t, c, MsgResult)
+			if r.Answer != 1 || len(r.Correct) != 2 || !r.Correct[0] || r.Correct[1] {
+				t.Fatalf("round %d result = %+v", q.Round, r)
+			}
+		}
+		rounds++
+		if rounds > game.VersusRounds {
+			t.Fatal("the match outlasted its rounds")
+		}
+	}
+}
+
+func TestDisconnectForfeits(t *testing.T) {
+	ana, ben := pair(t, startServer(t, 2*time.Second))
+	expect(t, ana, MsgQuestion)
+	expect(t, ben, MsgQuestion)
+	ben.Close()
+	over := expect(t, ana, MsgOver)
+	if over.Winner != 0 || over.Players[1].HP != 0 || over.Reason != "Ben disconnected" {
+		t.Errorf("over = %+v, want Ben to forfeit", over)
+	}
+}
+
+func TestTimeoutIsAMiss(t *testing.T) {
+	const timeLimit = 200 * time.Millisecond
+	ana, ben := pair(t, startServer(t, timeLimit))
+	q := expect(t, ana, MsgQuestion)
+	expect(t, ben, MsgQuestion)
+	asked := time.Now()
+	// Ben never answers
+	if err := ana.Answer(q.Round, 1); err != nil {
+		t.Fatal(err)
+	}
+	r := expect(t, ana, MsgResult)
+	if waited := time.Since(asked); waited < timeLimit {
+		t.Errorf("result after %v, before the question timed out", waited)
+	}
+	if len(r.Correct) != 2 || !r.Correct[0] || r.Correct[1] {
+		t.Fatalf("result = %+v, want Ana's hit and Ben's miss", r)
+	}
+	if ben := r.Players[1]; ben.Correct != 0 || ben.Shields != game.VersusShields-1 {
+		t.Errorf("Ben after the timeout = %+v", ben)
+	}
+	expect(t, ben, MsgResult)
+	// The match goes on to the next round
+	if next := expect(t, ana, MsgQuestion); next.Round != q.Round+1 {
+		t.Errorf("next question is round %d, want %d", next.Round, q.Round+1)
+	}
+}
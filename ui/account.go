@@ -0,0 +1,177 @@
+package ui
+
+import (
+	"image"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/RALPH22222/Broadside/game"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// Steps of signing in after the name entry
+const (
+	accountChoose   = iota // pick one of the captains with the entered name
+	accountNewPIN          // optionally set a PIN for a new captain
+	accountEnterPIN        // unlock the picked captain
+)
+
+// accountListMax is how many captains of one name the list shows
+const accountListMax = 5
+
+var pinFieldRect = image.Rect(312, 300, ScreenWidth-312, 348)
+
+// signIn looks up the captains with the entered name. The captain already signed
+// in stays signed in, so a player is not asked again after every battle.
+func (g *Game) signIn(name string) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return
+	}
+	if g.userID > 0 && game.SameName(name, g.playerName) {
+		g.state = StateMenu
+		return
+	}
+	g.userID = 0
+	g.playerName = name
+	g.loadProgress()
+	accounts, err := g.store.FindUsers(name)
+	if err != nil {
+		log.Printf("failed to look up user: %v", err)
+		g.state = StateMenu
+		return
+	}
+	if len(accounts) > accountListMax {
+		accounts = accounts[:accountListMax]
+	}
+	g.accounts = accounts
+	g.accountStep = accountChoose
+	if len(accounts) == 0 {
+		g.accountStep = accountNewPIN
+	}
+	g.pinInput = ""
+	g.accountMsg = ""
+	g.hoveredMenu = -1
+	g.state = StateAccount
+}
+
+// bindAccount signs the session in as the captain; everything saved from now on
+// builds up on that user.
+func (g *Game) bindAccount(a game.Account) {
+	g.userID = a.ID
+	g.playerName = a.Name
+	g.loadProgress()
+	g.enteredName = a.Name
+	g.accounts = nil
+	g.pinInput = ""
+	g.hoveredMenu = -1
+	g.state = StateMenu
+}
+
+// accountOptions are the rows of the captain list
+func (g *Game) accountOptions() []string {
+	var options []string
+	for _, a := range g.accounts {
+		options = append(options, a.Name+", "+itoa(a.Battles)+" battles")
+	}
+	return append(options, "New captain", "Back")
+}
+
+func (g *Game) drawAccount(screen *ebiten.Image) {
+	if g.accountStep == accountChoose {
+		g.drawMenuButtons(screen, "Welcome back! Which captain are you?", g.accountOptions(), nil)
+		for i, a := range g.accounts {
+			if a.HasPIN() && i < len(g.menuRects) {
+				r := g.menuRects[i]
+				drawWrappedTextWithShadow(screen, "PIN", g.confirmFont, r.Max.X+16, r.Min.Y+r.Dy()/2+8, 80, 24, VictoryGold)
+			}
+		}
+		return
+	}
+	title, msg, labels := "Captain "+g.playerName, "", []string{"Sign in", "Back"}
+	if g.accountStep == accountNewPIN {
+		msg = "New captain! Choose a PIN so nobody else can play as you, or leave it empty to skip."
+		labels[0] = "Create captain"
+	} else {
+		msg = "Enter your PIN."
+	}
+	drawWrappedTextWithShadow(screen, title, g.gameFont, practiceX, 120, practiceW, 36, VictoryGold)
+	drawWrappedTextWithShadow(screen, msg, g.confirmFont, practiceX, 200, practiceW, 24, SmokeWhite)
+	r := pinFieldRect
+	value := strings.Repeat("*", len([]rune(g.pinInput)))
+	if (time.Now().UnixNano()/500_000_000)%2 == 0 {
+		value += "|"
+	}
+	vector.DrawFilledRect(screen, float32(r.Min.X), float32(r.Min.Y), float32(r.Dx()), float32(r.Dy()), SmokeWhite, true)
+	vector.StrokeRect(screen, float32(r.Min.X), float32(r.Min.Y), float32(r.Dx()), float32(r.Dy()), 3, OceanTeal, true)
+	drawWrappedTextWithShadow(screen, value, g.gameFont, r.Min.X+12, r.Min.Y+36, r.Dx()-24, 36, NavyBlue)
+	drawWrappedTextWithShadow(screen, g.accountMsg, g.confirmFont, r.Min.X, r.Max.Y+40, r.Dx(), 24, AlertRed)
+	g.drawButtonRow(screen, labels, nil)
+}
+
+func (g *Game) updateAccount(mouseJustPressed bool) {
+	x, y := ebiten.CursorPosition()
+	if g.accountStep == accountChoose {
+		g.hoveredMenu = hitRect(g.menuRects, x, y)
+		if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+			g.state = StateNameEntry
+			return
+		}
+		if !mouseJustPressed || g.hoveredMenu < 0 {
+			return
+		}
+		switch i := g.hoveredMenu; {
+		case i < len(g.accounts):
+			if !g.accounts[i].HasPIN() {
+				g.bindAccount(g.accounts[i])
+				return
+			}
+			g.accountPick = i
+			g.accountStep = accountEnterPIN
+		case i == len(g.accounts):
+			g.accountStep = accountNewPIN
+		default:
+			g.state = StateNameEntry
+		}
+		g.pinInput = ""
+		g.accountMsg = ""
+		g.hoveredMenu = -1
+		return
+	}
+
+	g.hoveredMenu = hitRect(g.buttonRects, x, y)
+	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) || (mouseJustPressed && g.hoveredMenu == 1) {
+		g.pinInput = ""
+		g.accountMsg = ""
+		g.hoveredMenu = -1
+		if len(g.accounts) == 0 {
+			g.state = StateNameEntry
+			return
+		}
+		g.accountStep = accountChoose
+		return
+	}
+	editText(&g.pinInput, game.PINMaxLen)
+	confirm := inpututil.IsKeyJustPressed(ebiten.KeyEnter) || inpututil.IsKeyJustPressed(ebiten.KeyNumpadEnter) || (mouseJustPressed && g.hoveredMenu == 0)
+	if !confirm {
+		return
+	}
+	if g.accountStep == accountNewPIN {
+		a, err := game.CreateAccount(g.store, g.playerName, g.pinInput)
+		if err != nil {
+			log.Printf("failed to save user: %v", err)
+		}
+		g.bindAccount(a)
+		return
+	}
+	a := g.accounts[g.accountPick]
+	if err := a.Unlock(g.pinInput); err != nil {
+		g.accountMsg = "Wrong PIN, try again."
+		g.pinInput = ""
+		return
+	}
+	g.bindAccount(a)
+}
@@ -0,0 +1,84 @@
+package ui
+
+import (
+	"log"
+	"strings"
+
+	"github.com/RALPH22222/Broadside/game"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// startBossBattle launches the boss guarding the given difficulty. Its questions
+// are drawn from every subject and there is no bonus question.
+func (g *Game) startBossBattle(difficulty string) {
+	g.mode = game.ModeBoss
+	g.startCombat(nil, difficulty)
+	g.boss = game.GetBoss(g.level)
+	g.bossFrames = loadShipFrames(g.boss.Sprite)
+	g.bossPhase = 0
+	g.bossTurn = 0
+	g.enemyMaxHP = g.boss.MaxHP
+	g.enemyHP = g.boss.MaxHP
+	g.questionDuration = g.boss.Phases[0].TimeLimit
+	g.selectedSubject = "Mixed"
+
+	questions := g.quiz.SelectQuestions(g.quiz.ListSubjects(), difficulty, g.boss.Questions)
+	g.quizQuestions = make([]QuizQuestion, 0, len(questions))
+	for _, q := range questions {
+		g.quizQuestions = append(g.quizQuestions, QuizQuestion{
+			Question: q.Text,
+			Options:  q.Choices,
+			Answer:   q.AnswerIndex(),
+			Hint:     q.Hint,
+			Subject:  q.Subject,
+		})
+	}
+	g.bonusQIndex = -1
+	g.state = StatePlaying
+}
+
+// finishBossBattle handles the result of a boss battle: a win is saved as the
+// tier milestone and unlocks the next difficulty, a loss goes back to the
+// subjects, and the boss waits for the next finished one.
+func (g *Game) finishBossBattle() {
+	g.mode = game.ModeStandard
+	g.showStarModal = false
+	if g.enemyHP > 0 {
+		g.state = StateSelectSubject
+		return
+	}
+	if g.userID > 0 {
+		if err := g.store.RecordBossVictory(g.userID, g.selectedDifficulty, g.score); err != nil {
+			log.Printf("failed to save boss victory: %v", err)
+		}
+	}
+	g.progress.Clear(g.selectedDifficulty)
+	for i, d := range game.Difficulties {
+		if d == g.selectedDifficulty && i+1 < len(game.Difficulties) {
+			g.unlockedDifficulties[game.Difficulties[i+1]] = true
+		}
+	}
+	// Reset answeredSubjects for next difficulty
+	g.answeredSubjects = make(map[string]bool)
+	g.state = StateSelectDifficulty
+}
+
+// bossActionText describes a special attack or phase change after an answer.
+func (g *Game) bossActionText(cs game.CombatState) string {
+	var parts []string
+	if cs.SpecialAttack != "" {
+		parts = append(parts, g.boss.Name+" uses "+cs.SpecialAttack+"!")
+	}
+	if cs.Phase != g.bossPhase && cs.EnemyHP > 0 {
+		parts = append(parts, "Phase "+itoa(cs.Phase+1)+": "+g.boss.Phases[cs.Phase].Name+"!")
+	}
+	return strings.Join(parts, " ")
+}
+
+// drawBossHUD shows the boss's name and current phase in the battle HUD.
+func (g *Game) drawBossHUD(screen *ebiten.Image, x, y int) {
+	drawWrappedTextWithShadow(screen, "Boss: "+g.boss.Name, g.gameFont, x, y, ScreenWidth-200, 36, AlertRed)
+	phase := g.boss.Phases[g.bossPhase]
+	text := "Phase " + itoa(g.bossPhase+1) + "/" + itoa(len(g.boss.Phases)) + ": " + phase.Name
+	drawWrappedTextWithShadow(screen, text, g.confirmFont, x, y+80, ScreenWidth-200, 28, VictoryGold)
+}
@@ -0,0 +1,230 @@
+package ui
+
+import (
+	"image"
+	"image/color"
+	"log"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/RALPH22222/Broadside/game"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/hajimehoshi/ebiten/v2/text"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+	"golang.org/x/image/font"
+)
+
+// Sea chart area on screen
+const (
+	chartX = 60
+	chartY = 150
+	chartW = ScreenWidth - 120
+	chartH = 440
+)
+
+// openCampaigns loads the campaign data files and shows the campaign list.
+func (g *Game) openCampaigns() {
+	if g.campaigns == nil {
+		campaigns, err := game.LoadCampaigns("campaigns")
+		if err != nil {
+			log.Printf("failed to load campaigns: %v", err)
+		}
+		g.campaigns = campaigns
+	}
+	g.hoveredMenu = -1
+	g.state = StateCampaignSelect
+}
+
+func (g *Game) drawCampaignSelect(screen *ebiten.Image) {
+	options := make([]string, 0, len(g.campaigns)+1)
+	for _, c := range g.campaigns {
+		options = append(options, c.Name)
+	}
+	options = append(options, "Back")
+	g.drawMenuButtons(screen, "Select Campaign", options, nil)
+}
+
+func (g *Game) updateCampaignSelect(mouseJustPressed bool) {
+	x, y := ebiten.CursorPosition()
+	g.hoveredMenu = hitRect(g.menuRects, x, y)
+	if ebiten.IsKeyPressed(ebiten.KeyEscape) || (mouseJustPressed && g.hoveredMenu == len(g.campaigns)) {
+		g.state = StateMenu
+		return
+	}
+	if g.hoveredMenu < 0 || g.hoveredMenu >= len(g.campaigns) || !mouseJustPressed {
+		return
+	}
+	g.campaign = &g.campaigns[g.hoveredMenu]
+	g.campaignRun = nil
+	if g.userID > 0 {
+		run, err := g.store.LoadCampaignProgress(g.userID, g.campaign.ID)
+		if err != nil {
+			log.Printf("failed to load campaign progress: %v", err)
+		}
+		g.campaignRun = run
+	}
+	if g.campaignRun == nil {
+		g.campaignRun = game.NewCampaignRun(g.campaign)
+	}
+	g.mode = game.ModeCampaign
+	g.hoveredNode = -1
+	g.campaignMsg = "Choose a lane to sail."
+	g.state = StateCampaignMap
+}
+
+// chartPos converts a node's chart position to screen coordinates
+func chartPos(n *game.CampaignNode) (int, int) {
+	return chartX + int(n.X*chartW), chartY + int(n.Y*chartH)
+}
+
+func (g *Game) drawCampaignMap(screen *ebiten.Image) {
+	c, run := g.campaign, g.campaignRun
+	drawWrappedTextWithShadow(screen, c.Name, g.gameFont, 40, 60, ScreenWidth-80, 36, VictoryGold)
+	status := "Hull: " + itoa(run.HP) + "/" + itoa(g.campaignMaxHP()) + "   Shields: " + itoa(run.Shields)
+	drawWrappedTextWithShadow(screen, status, g.gameFont, 40, 104, ScreenWidth-80, 36, SmokeWhite)
+
+	// Chart background
+	vector.DrawFilledRect(screen, chartX, chartY, chartW, chartH, color.RGBA{0, 31, 63, 160}, true)
+	vector.StrokeRect(screen, chartX, chartY, chartW, chartH, 3, VictoryGold, true)
+
+	// Sea lanes
+	for i := range c.Nodes {
+		from := &c.Nodes[i]
+		fx, fy := chartPos(from)
+		for _, id := range from.Next {
+			to := c.Node(id)
+			tx, ty := chartPos(to)
+			laneCol := GunmetalGray
+			if run.Completed[from.ID] && run.Completed[to.ID] {
+				laneCol = VictoryGold
+			} else if run.Completed[from.ID] {
+				laneCol = OceanTeal
+			}
+			vector.StrokeLine(screen, float32(fx), float32(fy), float32(tx), float32(ty), 4, laneCol, true)
+		}
+	}
+
+	// Nodes
+	t := float64(time.Now().UnixNano()) / 1e9
+	g.campaignNodeRects = g.campaignNodeRects[:0]
+	for i := range c.Nodes {
+		n := &c.Nodes[i]
+		nx, ny := chartPos(n)
+		col := GunmetalGray
+		switch {
+		case run.Completed[n.ID]:
+			col = VictoryGold
+		case run.CanSail(c, n.ID):
+			col = OceanTeal
+		}
+		radius := float32(20)
+		if g.hoveredNode == i && run.CanSail(c, n.ID) {
+			radius += float32(3 * math.Sin(t*6))
+		}
+		if n.Type == game.NodePort {
+			vector.DrawFilledRect(screen, float32(nx)-radius, float32(ny)-radius, radius*2, radius*2, col, true)
+			vector.StrokeRect(screen, float32(nx)-radius, float32(ny)-radius, radius*2, radius*2, 2, SmokeWhite, true)
+		} else {
+			vector.DrawFilledCircle(screen, float32(nx), float32(ny), radius, col, true)
+			vector.StrokeCircle(screen, float32(nx), float32(ny), radius, 2, AlertRed, true)
+		}
+		bounds, _ := font.BoundString(g.confirmFont, n.Name)
+		nameW := (bounds.Max.X - bounds.Min.X).Ceil()
+		text.Draw(screen, n.Name, g.confirmFont, nx-nameW/2, ny+44, SmokeWhite)
+		g.campaignNodeRects = append(g.campaignNodeRects, image.Rect(nx-24, ny-24, nx+24, ny+24))
+	}
+
+	// Player ship marker at the current node
+	if cur := c.Node(run.Current); cur != nil && g.playerShipFrames[0] != nil {
+		cx, cy := chartPos(cur)
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Scale(56.0/64.0, 40.0/64.0)
+		op.GeoM.Translate(float64(cx-28), float64(cy-64))
+		screen.DrawImage(g.playerShipFrames[0], op)
+	}
+
+	// Details of the hovered node
+	infoY := chartY + chartH + 40
+	if g.hoveredNode >= 0 && g.hoveredNode < len(c.Nodes) {
+		n := &c.Nodes[g.hoveredNode]
+		info := n.Name + ": "
+		if n.Type == game.NodePort {
+			info += "repair port, restores " + itoa(n.Repair) + " hull and all shields"
+		} else {
+			info += n.Difficulty + " battle - " + strings.Join(n.Subjects, ", ")
+		}
+		drawWrappedTextWithShadow(screen, info, g.confirmFont, 40, infoY, ScreenWidth-80, 24, SmokeWhite)
+	}
+	msg := g.campaignMsg
+	if run.IsComplete(c) {
+		msg = "Campaign complete! Every sea lane is yours."
+	}
+	drawWrappedTextWithShadow(screen, msg, g.confirmFont, 40, infoY+36, ScreenWidth-80, 24, VictoryGold)
+	drawWrappedTextWithShadow(screen, "(ESC to return to menu)", g.confirmFont, 40, ScreenHeight-32, ScreenWidth-80, 24, SmokeWhite)
+}
+
+func (g *Game) updateCampaignMap(mouseJustPressed bool) {
+	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+		g.mode = game.ModeStandard
+		g.state = StateMenu
+		return
+	}
+	x, y := ebiten.CursorPosition()
+	g.hoveredNode = hitRect(g.campaignNodeRects, x, y)
+	if g.hoveredNode < 0 || !mouseJustPressed {
+		return
+	}
+	node := &g.campaign.Nodes[g.hoveredNode]
+	if !g.campaignRun.CanSail(g.campaign, node.ID) {
+		if !g.campaignRun.Completed[node.ID] {
+			g.campaignMsg = "No open sea lane to " + node.Name + " yet."
+		}
+		return
+	}
+	if node.Type == game.NodePort {
+		g.campaignRun.VisitPort(node)
+		g.campaignMsg = "Repaired at " + node.Name + "."
+		g.saveCampaign()
+		return
+	}
+	g.campaignNode = node
+	g.selectedDifficulty = node.Difficulty
+	g.selectedSubject = strings.Join(node.Subjects, ",")
+	g.startCombat(node.Subjects, node.Difficulty)
+	// Damage carries over from earlier battles
+	g.playerHP = g.campaignRun.HP
+	g.playerShields = g.campaignRun.Shields
+	g.state = StatePlaying
+}
+
+// finishCampaignBattle carries the battle outcome back onto the sea chart.
+func (g *Game) finishCampaignBattle() {
+	won := g.enemyHP == 0 || g.starCount >= 1
+	g.campaignRun.FinishBattle(g.campaignNode, won, g.playerHP, g.playerShields)
+	switch {
+	case won:
+		g.campaignMsg = g.campaignNode.Name + " cleared!"
+	case g.playerHP == 0:
+		g.campaignMsg = "Your ship was sunk and towed back. Try " + g.campaignNode.Name + " again."
+	default:
+		g.campaignMsg = "The enemy at " + g.campaignNode.Name + " held on. Try again."
+	}
+	g.saveCampaign()
+	g.showStarModal = false
+	g.state = StateCampaignMap
+}
+
+func (g *Game) saveCampaign() {
+	if g.userID == 0 {
+		return
+	}
+	if err := g.store.SaveCampaignProgress(g.userID, g.campaignRun); err != nil {
+		log.Printf("failed to save campaign progress: %v", err)
+	}
+}
+
+func (g *Game) campaignMaxHP() int {
+	return game.InitCombatState("Easy").PlayerMaxHP
+}
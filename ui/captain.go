@@ -0,0 +1,121 @@
+package ui
+
+import (
+	"time"
+
+	"github.com/RALPH22222/Broadside/game"
+)
+
+// Computer captains hold their turn card up for a moment, and pause between
+// taking the party buzzer and answering
+const (
+	captainIntroTime  = 1500 * time.Millisecond
+	captainAnswerTime = 800 * time.Millisecond
+)
+
+// nextCaptain cycles a seat through the personalities: -1 is a person, otherwise
+// the index into game.Personalities.
+func nextCaptain(i int) int {
+	if i+1 >= len(game.Personalities) {
+		return -1
+	}
+	return i + 1
+}
+
+// newCaptain seats the personality with a fresh seed.
+func newCaptain(i int) *game.AICaptain {
+	return game.NewAICaptain(game.Personalities[i], time.Now().UnixNano())
+}
+
+// seatLabel names who sits in a seat in the setup screens.
+func seatLabel(captain int) string {
+	if captain < 0 {
+		return "Human"
+	}
+	return "Computer: " + game.Personalities[captain].Name
+}
+
+// seatQuestion hands a battle question to a seat the way it was in the quiz bank.
+func (g *Game) seatQuestion(q QuizQuestion) game.Question {
+	answer := ""
+	if q.Answer >= 0 && q.Answer < len(q.Options) {
+		answer = q.Options[q.Answer]
+	}
+	return game.Question{Text: q.Question, Choices: q.Options, Answer: answer, Subject: q.Subject, Difficulty: g.selectedDifficulty}
+}
+
+// turnSeat is the seat answering next in a turn-based battle, nil in other modes.
+func (g *Game) turnSeat() game.Seat {
+	switch {
+	case g.mode == game.ModeVersus && g.versus != nil:
+		return g.versus.Players[g.versus.Turn].Seat
+	case g.mode == game.ModeFleet && g.fleet != nil:
+		return g.fleet.Ships[g.fleet.Turn].Seat
+	}
+	return nil
+}
+
+// computerTurn reports whether a computer captain answers the current question.
+func (g *Game) computerTurn() bool {
+	s := g.turnSeat()
+	return s != nil && game.IsComputer(s)
+}
+
+// updateComputerTurn lets the computer captain answer once its thinking time has passed.
+func (g *Game) updateComputerTurn() {
+	if g.showFeedback || g.currentQ >= len(g.quizQuestions) {
+		return
+	}
+	q := g.quizQuestions[g.currentQ]
+	if !g.captainPlanned {
+		g.captainChoice, g.captainDelay, _ = g.turnSeat().Decide(g.seatQuestion(q))
+		g.captainPlanned = true
+	}
+	if time.Since(g.questionTimer) < g.captainDelay {
+		return
+	}
+	isCorrect := g.captainChoice == q.Answer
+	g.selectedAns = g.captainChoice
+	g.processAnswer(isCorrect, false, g.captainDelay)
+	g.showFeedback = true
+	g.feedbackTime = time.Now()
+	g.feedbackRight = isCorrect
+	g.timerActive = false
+}
+
+// updatePartyCaptains lets computer seats buzz in once they are sure enough, and
+// answer a moment after taking the buzzer. It reports whether a captain acted.
+func (g *Game) updatePartyCaptains() bool {
+	if g.party.Buzzed >= 0 {
+		i := g.party.Buzzed
+		if !game.IsComputer(g.party.Players[i].Seat) || time.Since(g.partyClock) < captainAnswerTime {
+			return false
+		}
+		g.answerParty(g.partyPlans[i].choice)
+		return true
+	}
+	q := g.quizQuestions[g.currentQ]
+	for i, p := range g.party.Players {
+		if !game.IsComputer(p.Seat) || g.party.LockedOut[i] {
+			continue
+		}
+		plan := &g.partyPlans[i]
+		if !plan.ready {
+			plan.choice, plan.delay, _ = p.Seat.Decide(g.seatQuestion(q))
+			plan.ready = true
+		}
+		if time.Since(g.partyQuestionTime) >= plan.delay && g.party.Buzz(i) {
+			g.partyClock = time.Now()
+			g.partyMsg = p.Name + " buzzed in!"
+			return true
+		}
+	}
+	return false
+}
+
+// captainPlan is a computer seat's answer to the current party question
+type captainPlan struct {
+	ready  bool
+	choice int
+	delay  time.Duration
+}
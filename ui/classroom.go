@@ -0,0 +1,439 @@
+package ui
+
+import (
+	"image"
+	"log"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/RALPH22222/Broadside/game"
+	"github.com/RALPH22222/Broadside/netplay"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// editText applies this tick's typing to s, keeping it under max bytes.
+func editText(s *string, max int) {
+	for _, r := range ebiten.AppendInputChars(nil) {
+		if len(*s) < max {
+			*s += string(r)
+		}
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyBackspace) && len(*s) > 0 {
+		_, size := utf8.DecodeLastRuneInString(*s)
+		*s = (*s)[:len(*s)-size]
+	}
+}
+
+// drawFlagshipBar draws the hull of the flagship the class is fighting.
+func (g *Game) drawFlagshipBar(screen *ebiten.Image, y, hp, maxHP int) {
+	if maxHP <= 0 {
+		return
+	}
+	x, w := practiceX, practiceW
+	vector.DrawFilledRect(screen, float32(x), float32(y), float32(w), 20, GunmetalGray, true)
+	vector.DrawFilledRect(screen, float32(x), float32(y), float32(w*hp/maxHP), 20, AlertRed, true)
+	vector.StrokeRect(screen, float32(x), float32(y), float32(w), 20, 2, VictoryGold, true)
+	drawWrappedTextWithShadow(screen, "Flagship "+itoa(hp)+"/"+itoa(maxHP), g.confirmFont, x, y-8, w, 24, SmokeWhite)
+}
+
+// --- Host side ---
+
+// startClassHost opens a session on this machine for the chosen subject and difficulty.
+func (g *Game) startClassHost(subject, difficulty string) {
+	room, err := netplay.OpenClassroom(g.quiz, subject, difficulty, netplay.ClassAddr)
+	if err != nil {
+		log.Printf("failed to open classroom: %v", err)
+		g.classMsg = "Could not open the session: " + err.Error()
+		g.mode = game.ModeStandard
+		g.state = StateMultiplayerSelect
+		return
+	}
+	g.classroom = room
+	g.classSaved = false
+	g.classMsg = ""
+	g.hoveredMenu = -1
+	g.state = StateClassHost
+}
+
+// saveClassReport stores the report of the finished session once.
+func (g *Game) saveClassReport() {
+	if g.classSaved {
+		return
+	}
+	g.classSaved = true
+	report := g.classroom.Report()
+	report.HostUserID = g.userID
+	if len(report.Students) == 0 {
+		return
+	}
+	if err := g.store.SaveClassReport(report); err != nil {
+		log.Printf("failed to save class report: %v", err)
+		g.classMsg = "The class report could not be saved."
+		return
+	}
+	g.classMsg = "Class report saved."
+}
+
+// closeClassHost ends the session and returns to the menu.
+func (g *Game) closeClassHost() {
+	g.classroom.Close()
+	g.classroom = nil
+	g.mode = game.ModeStandard
+	g.state = StateMenu
+}
+
+// classHostButtons are the buttons of the host screen for each phase
+func classHostButtons(phase netplay.ClassPhase) []string {
+	switch phase {
+	case netplay.ClassLobby:
+		return []string{"Start", "Cancel"}
+	case netplay.ClassAsking:
+		return []string{"End Session"}
+	case netplay.ClassReveal:
+		return []string{"Next", "End Session"}
+	}
+	return []string{"Main Menu"}
+}
+
+func (g *Game) updateClassHost(mouseJustPressed bool) {
+	v := g.classroom.View()
+	if v.Phase == netplay.ClassOver {
+		g.saveClassReport()
+	}
+	x, y := ebiten.CursorPosition()
+	g.hoveredMenu = hitRect(g.buttonRects, x, y)
+	pressed := -1
+	if mouseJustPressed {
+		pressed = g.hoveredMenu
+	}
+	// The teacher can also drive the session from the keyboard while projecting
+	if inpututil.IsKeyJustPressed(ebiten.KeyEnter) || inpututil.IsKeyJustPressed(ebiten.KeySpace) {
+		pressed = 0
+	}
+	if pressed < 0 || pressed >= len(classHostButtons(v.Phase)) {
+		return
+	}
+	switch label := classHostButtons(v.Phase)[pressed]; label {
+	case "Start":
+		if !g.classroom.Start() {
+			g.classMsg = "Wait for at least one student to join."
+		}
+	case "Next":
+		g.classroom.Next()
+	case "End Session":
+		g.classroom.End()
+	case "Cancel", "Main Menu":
+		g.closeClassHost()
+	}
+}
+
+func (g *Game) drawClassHost(screen *ebiten.Image) {
+	v := g.classroom.View()
+	header := "Room " + v.Code + " - " + g.classroom.Subject + " (" + g.classroom.Difficulty + ")"
+	drawWrappedTextWithShadow(screen, header, g.gameFont, 40, 50, ScreenWidth-80, 36, VictoryGold)
+	switch v.Phase {
+	case netplay.ClassLobby:
+		g.drawClassLobby(screen, v)
+	case netplay.ClassAsking, netplay.ClassReveal:
+		g.drawClassQuestion(screen, v)
+	case netplay.ClassOver:
+		g.drawClassReport(screen, v)
+	}
+	drawWrappedTextWithShadow(screen, g.classMsg, g.confirmFont, 40, ScreenHeight-120, ScreenWidth-80, 24, VictoryGold)
+	g.drawButtonRow(screen, classHostButtons(v.Phase), nil)
+}
+
+func (g *Game) drawClassLobby(screen *ebiten.Image, v netplay.ClassView) {
+	drawWrappedTextWithShadow(screen, "Room code: "+v.Code, g.gameFont, practiceX, 140, practiceW, 36, SmokeWhite)
+	port := itoa(g.classroom.Port())
+	join := "Students press TAB on the name screen and connect to "
+	if addrs := netplay.LocalAddrs(); len(addrs) > 0 {
+		join += strings.Join(addrs, ":"+port+" or ") + ":" + port
+	} else {
+		join += "this computer on port " + port
+	}
+	drawWrappedTextWithShadow(screen, join, g.confirmFont, practiceX, 190, practiceW, 24, OceanTeal)
+	drawWrappedTextWithShadow(screen, itoa(len(v.Students))+" students joined:", g.gameFont, practiceX, 270, practiceW, 36, VictoryGold)
+	// Names in four columns
+	colW := practiceW / 4
+	for i, s := range v.Students {
+		col := SmokeWhite
+		if !s.Connected {
+			col = GunmetalGray
+		}
+		drawWrappedTextWithShadow(screen, s.Name, g.confirmFont, practiceX+(i%4)*colW, 310+(i/4)*30, colW-8, 24, col)
+	}
+}
+
+func (g *Game) drawClassQuestion(screen *ebiten.Image, v netplay.ClassView) {
+	revealed := v.Phase == netplay.ClassReveal
+	status := "Question " + itoa(v.Round+1) + "/" + itoa(v.Rounds) + "   Answers: " + itoa(v.Answered) + "/" + itoa(len(v.Students))
+	if !revealed {
+		status += "   Time: " + itoa(int(v.Remaining.Seconds())+1) + "s"
+	}
+	drawWrappedTextWithShadow(screen, status, g.confirmFont, 40, 90, ScreenWidth-80, 24, SmokeWhite)
+	g.drawFlagshipBar(screen, 120, v.Flagship, v.FlagshipMax)
+
+	q := QuizQuestion{Question: v.Question.Text, Options: append([]string(nil), v.Question.Choices...), Answer: -1}
+	if revealed {
+		q.Answer = v.Answer
+		for i := range q.Options {
+			if i < len(v.Counts) {
+				q.Options[i] += " (" + itoa(v.Counts[i]) + ")"
+			}
+		}
+	}
+	y := 190
+	drawWrappedTextWithShadow(screen, q.Question, g.gameFont, practiceX, y, practiceW, 36, SmokeWhite)
+	y += len(wrapText(g.gameFont, q.Question, practiceW))*36 + 12
+	g.selectedAns = -1
+	y = g.drawChoiceList(screen, q, y, revealed)
+	if !revealed {
+		return
+	}
+	// Live standings
+	for i, s := range v.Students {
+		if i == 5 {
+			break
+		}
+		line := itoa(i+1) + ". " + s.Name + " - " + itoa(s.Score) + " pts (" + itoa(s.Correct) + "/" + itoa(s.Answered) + ")"
+		drawWrappedTextWithShadow(screen, line, g.confirmFont, practiceX, y+24+i*28, practiceW, 24, SmokeWhite)
+	}
+}
+
+func (g *Game) drawClassReport(screen *ebiten.Image, v netplay.ClassView) {
+	report := g.classroom.Report()
+	result := "The flagship escaped."
+	if report.FlagshipSunk {
+		result = "The class sank the flagship!"
+	}
+	drawWrappedTextWithShadow(screen, result, g.gameFont, practiceX, 120, practiceW, 36, VictoryGold)
+	summary := itoa(len(report.Students)) + " students, " + itoa(report.Questions) + " questions, class accuracy " + itoa(report.Accuracy()) + "%"
+	drawWrappedTextWithShadow(screen, summary, g.confirmFont, practiceX, 160, practiceW, 24, SmokeWhite)
+	for i, s := range report.Students {
+		if i == 12 {
+			break
+		}
+		line := itoa(i+1) + ". " + s.Name + " - " + itoa(s.Score) + " pts, " + itoa(s.Correct) + "/" + itoa(s.Answered) + " correct, avg " + formatSeconds(s.AvgResponse())
+		drawWrappedTextWithShadow(screen, line, g.confirmFont, practiceX, 210+i*28, practiceW, 24, SmokeWhite)
+	}
+}
+
+// --- Student side ---
+
+// Fields of the join form
+const (
+	classFieldName = iota
+	classFieldRoom
+	classFieldAddr
+	classFieldCount
+)
+
+var classFieldLabels = []string{"Your name:", "Room code:", "Teacher's computer:"}
+
+func classFieldRect(i int) image.Rectangle {
+	return image.Rect(312, 200+i*110, ScreenWidth-212, 248+i*110)
+}
+
+// openClassJoin replaces the name entry with the classroom join form.
+func (g *Game) openClassJoin() {
+	if g.classJoin[classFieldName] == "" {
+		g.classJoin[classFieldName] = g.enteredName
+	}
+	g.classField = classFieldName
+	g.classMsg = ""
+	g.state = StateClassJoin
+}
+
+func (g *Game) drawClassJoin(screen *ebiten.Image) {
+	drawWrappedTextWithShadow(screen, "Join a class", g.gameFont, 40, 80, ScreenWidth-80, 36, VictoryGold)
+	for i, label := range classFieldLabels {
+		r := classFieldRect(i)
+		drawWrappedTextWithShadow(screen, label, g.gameFont, r.Min.X, r.Min.Y-16, r.Dx(), 36, VictoryGold)
+		border := NavyBlue
+		value := g.classJoin[i]
+		if g.classField == i {
+			border = OceanTeal
+			if (time.Now().UnixNano()/500_000_000)%2 == 0 {
+				value += "|"
+			}
+		}
+		vector.DrawFilledRect(screen, float32(r.Min.X), float32(r.Min.Y), float32(r.Dx()), float32(r.Dy()), SmokeWhite, true)
+		vector.StrokeRect(screen, float32(r.Min.X), float32(r.Min.Y), float32(r.Dx()), float32(r.Dy()), 3, border, true)
+		drawWrappedTextWithShadow(screen, value, g.gameFont, r.Min.X+12, r.Min.Y+36, r.Dx()-24, 36, NavyBlue)
+	}
+	drawWrappedTextWithShadow(screen, g.classMsg, g.confirmFont, 312, 560, ScreenWidth-524, 24, AlertRed)
+	drawWrappedTextWithShadow(screen, "(TAB to switch, ENTER to join, ESC to go back)", g.confirmFont, 40, ScreenHeight-32, ScreenWidth-80, 24, SmokeWhite)
+}
+
+func (g *Game) updateClassJoin(mouseJustPressed bool) {
+	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+		g.state = StateNameEntry
+		return
+	}
+	if mouseJustPressed {
+		x, y := ebiten.CursorPosition()
+		for i := 0; i < classFieldCount; i++ {
+			if image.Pt(x, y).In(classFieldRect(i)) {
+				g.classField = i
+			}
+		}
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyTab) {
+		g.classField = (g.classField + 1) % classFieldCount
+	}
+	limits := []int{16, 8, 64}
+	editText(&g.classJoin[g.classField], limits[g.classField])
+	if !inpututil.IsKeyJustPressed(ebiten.KeyEnter) && !inpututil.IsKeyJustPressed(ebiten.KeyNumpadEnter) {
+		return
+	}
+	name := strings.TrimSpace(g.classJoin[classFieldName])
+	room := strings.ToUpper(strings.TrimSpace(g.classJoin[classFieldRoom]))
+	addr := strings.TrimSpace(g.classJoin[classFieldAddr])
+	for i, v := range []string{name, room, addr} {
+		if v == "" {
+			g.classField = i
+			g.classMsg = "Fill in " + strings.ToLower(strings.TrimSuffix(classFieldLabels[i], ":")) + "."
+			return
+		}
+	}
+	if !strings.Contains(addr, ":") {
+		addr += netplay.ClassAddr
+	}
+	c, err := netplay.JoinClass(addr, room, name)
+	if err != nil {
+		g.classMsg = "Could not reach the teacher's computer: " + err.Error()
+		return
+	}
+	g.playerName = name
+	g.classClient = c
+	g.classQuestion = netplay.Message{Round: -1}
+	g.classResult = nil
+	g.classOver = nil
+	g.classJoined = false
+	g.classMe = netplay.PlayerState{}
+	g.classFlagshipMax = 0
+	g.classMsg = "Connecting..."
+	g.hoveredMenu = -1
+	g.state = StateClassStudent
+}
+
+// leaveClass disconnects and goes back to the name entry.
+func (g *Game) leaveClass() {
+	g.classClient.Close()
+	g.classClient = nil
+	g.state = StateNameEntry
+}
+
+func (g *Game) updateClassStudent(mouseJustPressed bool) {
+	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+		g.leaveClass()
+		return
+	}
+	for {
+		m, ok := g.classClient.Poll()
+		if !ok {
+			break
+		}
+		g.handleClassMessage(m)
+	}
+	if g.classOver != nil || g.classClient.Err() != nil {
+		// A rejected join keeps the server's reason on screen
+		if g.classOver == nil && g.classJoined {
+			g.classMsg = "Lost the connection to the teacher's computer."
+		}
+		x, y := ebiten.CursorPosition()
+		g.hoveredMenu = hitRect(g.buttonRects, x, y)
+		if mouseJustPressed && g.hoveredMenu == 0 {
+			g.leaveClass()
+		}
+		return
+	}
+	if g.classQuestion.Round >= 0 && g.classResult == nil && g.selectedAns < 0 {
+		if choice := g.pickedChoice(mouseJustPressed); choice >= 0 {
+			if err := g.classClient.Answer(g.classQuestion.Round, choice); err != nil {
+				g.classMsg = "Could not send the answer."
+				return
+			}
+			g.selectedAns = choice
+		}
+	}
+}
+
+// handleClassMessage applies a message from the teacher's session.
+func (g *Game) handleClassMessage(m netplay.Message) {
+	if len(m.Players) > 0 {
+		g.classMe = m.Players[0]
+	}
+	if m.FlagshipMax > 0 {
+		g.classFlagship, g.classFlagshipMax = m.Flagship, m.FlagshipMax
+	}
+	switch m.Type {
+	case netplay.MsgJoined:
+		g.classJoined = true
+		g.classMsg = "Joined " + m.Name + " (" + m.Difficulty + "). Waiting for the teacher to start..."
+	case netplay.MsgQuestion:
+		g.classQuestion = m
+		g.classQuestionTime = time.Now()
+		g.classResult = nil
+		g.selectedAns = -1
+		g.classMsg = ""
+	case netplay.MsgResult:
+		g.classResult = &m
+		g.classMsg = "Missed! The flagship fires on you."
+		if len(m.Correct) > 0 && m.Correct[0] {
+			g.classMsg = "Hit! You're in place " + itoa(m.Rank) + "."
+		}
+	case netplay.MsgOver:
+		g.classOver = &m
+		g.classMsg = m.Reason
+	case netplay.MsgError:
+		g.classMsg = m.Error
+	}
+}
+
+func (g *Game) drawClassStudent(screen *ebiten.Image) {
+	me := g.classMe
+	drawWrappedTextWithShadow(screen, g.playerName+": "+itoa(me.Score)+" pts", g.gameFont, 40, 50, ScreenWidth-80, 36, VictoryGold)
+	if g.classFlagshipMax > 0 {
+		drawWrappedTextWithShadow(screen, "HP "+itoa(me.HP)+"  Shields "+itoa(me.Shields), g.gameFont, ScreenWidth-400, 50, 360, 36, OceanTeal)
+		g.drawFlagshipBar(screen, 100, g.classFlagship, g.classFlagshipMax)
+	}
+	if g.classOver != nil {
+		drawWrappedTextWithShadow(screen, g.classMsg, g.gameFont, practiceX, 260, practiceW, 36, VictoryGold)
+		final := "You finished in place " + itoa(g.classOver.Rank) + " with " + itoa(me.Correct) + " correct answers."
+		drawWrappedTextWithShadow(screen, final, g.gameFont, practiceX, 310, practiceW, 36, SmokeWhite)
+		g.drawButtonRow(screen, []string{"Leave"}, nil)
+		return
+	}
+	if g.classClient.Err() != nil || g.classQuestion.Round < 0 {
+		drawWrappedTextWithShadow(screen, g.classMsg, g.gameFont, practiceX, 300, practiceW, 36, SmokeWhite)
+		if g.classClient.Err() != nil {
+			g.drawButtonRow(screen, []string{"Leave"}, nil)
+		}
+		return
+	}
+	q := QuizQuestion{Question: g.classQuestion.Text, Options: g.classQuestion.Choices, Answer: -1}
+	if g.classResult != nil {
+		q.Answer = g.classResult.Answer
+	} else {
+		left := time.Duration(g.classQuestion.TimeLimit)*time.Millisecond - time.Since(g.classQuestionTime)
+		if left < 0 {
+			left = 0
+		}
+		drawWrappedTextWithShadow(screen, "Time: "+itoa(int(left.Seconds())+1)+"s", g.confirmFont, ScreenWidth-240, 150, 200, 24, SmokeWhite)
+	}
+	drawWrappedTextWithShadow(screen, "Question "+itoa(g.classQuestion.Round+1), g.confirmFont, 40, 150, 300, 24, SmokeWhite)
+	y := 200
+	drawWrappedTextWithShadow(screen, q.Question, g.gameFont, practiceX, y, practiceW, 36, SmokeWhite)
+	y += len(wrapText(g.gameFont, q.Question, practiceW))*36 + 12
+	y = g.drawChoiceList(screen, q, y, g.classResult != nil)
+	status := g.classMsg
+	if g.classResult == nil && g.selectedAns >= 0 {
+		status = "Answer locked in. Eyes on the board!"
+	}
+	drawWrappedTextWithShadow(screen, status, g.gameFont, practiceX, y+24, practiceW, 36, VictoryGold)
+}
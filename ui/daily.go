@@ -0,0 +1,129 @@
+package ui
+
+import (
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/RALPH22222/Broadside/game"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+// openDaily loads today's challenge, the player's daily history and today's leaderboard.
+func (g *Game) openDaily() {
+	g.daily = g.quiz.NewDailyChallenge(time.Now())
+	g.dailyPlayed = false
+	g.dailyHistory = nil
+	g.dailyMsg = ""
+	if g.userID > 0 {
+		played, err := g.store.HasPlayedDaily(g.userID, g.daily.Date)
+		if err != nil {
+			log.Printf("failed to check daily challenge: %v", err)
+		}
+		g.dailyPlayed = played
+		history, err := g.store.GetDailyHistory(g.userID)
+		if err != nil {
+			log.Printf("failed to load daily history: %v", err)
+		}
+		g.dailyHistory = history
+	} else {
+		g.dailyMsg = "Enter a captain name to take the daily challenge."
+	}
+	top, err := g.store.GetDailyLeaderboard(g.daily.Date, 5)
+	if err != nil {
+		log.Printf("failed to load daily leaderboard: %v", err)
+	}
+	g.dailyTop = top
+	g.hoveredMenu = -1
+	g.state = StateDaily
+}
+
+// startDaily uses up today's attempt and starts the seeded battle.
+func (g *Game) startDaily() {
+	if err := g.store.StartDailyAttempt(g.userID, g.daily); err != nil {
+		if errors.Is(err, game.ErrDailyPlayed) {
+			g.dailyPlayed = true
+			g.dailyMsg = "You already sailed today's challenge. Come back tomorrow!"
+		} else {
+			log.Printf("failed to start daily challenge: %v", err)
+			g.dailyMsg = "The daily challenge is unavailable right now."
+		}
+		return
+	}
+	g.mode = game.ModeDaily
+	g.startCombat(nil, g.daily.Difficulty)
+	g.setBattleQuestions(g.daily.Questions(g.quiz))
+	g.selectedDifficulty = g.daily.Difficulty
+	g.selectedSubject = "Daily"
+	g.state = StatePlaying
+}
+
+// saveDaily records the result of today's attempt.
+func (g *Game) saveDaily() {
+	if g.userID <= 0 {
+		return
+	}
+	if err := g.store.SaveDailyResult(g.userID, g.daily.Date, g.score, g.longestStreak); err != nil {
+		log.Printf("failed to save daily challenge: %v", err)
+	}
+}
+
+func (g *Game) drawDaily(screen *ebiten.Image) {
+	d := g.daily
+	drawWrappedTextWithShadow(screen, "Daily Challenge: "+d.Date, g.gameFont, 40, 60, ScreenWidth-80, 36, VictoryGold)
+	info := d.Difficulty + " - " + itoa(game.DailyQuestionCount) + " questions from every subject, the same for every captain. One attempt a day."
+	drawWrappedTextWithShadow(screen, info, g.confirmFont, 40, 104, ScreenWidth-80, 24, SmokeWhite)
+
+	// Streaks and recent history
+	dates := make([]string, len(g.dailyHistory))
+	for i, r := range g.dailyHistory {
+		dates[i] = r.Date
+	}
+	current, best := game.DailyStreaks(dates, d.Date)
+	streak := "Daily streak: " + itoa(current) + " days (best " + itoa(best) + ")"
+	drawWrappedTextWithShadow(screen, streak, g.gameFont, 40, 170, ScreenWidth/2-60, 36, OceanTeal)
+	drawWrappedTextWithShadow(screen, "Your recent days", g.confirmFont, 40, 220, ScreenWidth/2-60, 24, VictoryGold)
+	recent := g.dailyHistory
+	if len(recent) > 7 {
+		recent = recent[len(recent)-7:]
+	}
+	for i := range recent {
+		r := recent[len(recent)-1-i]
+		line := r.Date + "   " + itoa(r.Score) + " pts   streak " + itoa(r.LongestStreak)
+		drawWrappedTextWithShadow(screen, line, g.confirmFont, 40, 252+i*28, ScreenWidth/2-60, 24, SmokeWhite)
+	}
+	if len(recent) == 0 {
+		drawWrappedTextWithShadow(screen, "No daily challenges yet.", g.confirmFont, 40, 252, ScreenWidth/2-60, 24, SmokeWhite)
+	}
+
+	// Today's leaderboard
+	drawWrappedTextWithShadow(screen, "Today's best", g.confirmFont, ScreenWidth/2+20, 220, ScreenWidth/2-60, 24, VictoryGold)
+	for i, e := range g.dailyTop {
+		line := itoa(i+1) + ". " + strings.TrimSpace(e.PlayerName) + "   " + itoa(e.Score) + " pts"
+		drawWrappedTextWithShadow(screen, line, g.confirmFont, ScreenWidth/2+20, 252+i*28, ScreenWidth/2-60, 24, SmokeWhite)
+	}
+	if len(g.dailyTop) == 0 {
+		drawWrappedTextWithShadow(screen, "Nobody has sailed today yet.", g.confirmFont, ScreenWidth/2+20, 252, ScreenWidth/2-60, 24, SmokeWhite)
+	}
+
+	msg := g.dailyMsg
+	if msg == "" && g.dailyPlayed {
+		msg = "Today's attempt is done. Come back tomorrow!"
+	}
+	drawWrappedTextWithShadow(screen, msg, g.confirmFont, 40, ScreenHeight-140, ScreenWidth-80, 24, VictoryGold)
+	g.drawButtonRow(screen, []string{"Set Sail", "Back"}, []bool{g.dailyPlayed || g.userID <= 0})
+}
+
+func (g *Game) updateDaily(mouseJustPressed bool) {
+	x, y := ebiten.CursorPosition()
+	g.hoveredMenu = hitRect(g.buttonRects, x, y)
+	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) || (mouseJustPressed && g.hoveredMenu == 1) {
+		g.state = StateModeSelect
+		return
+	}
+	if mouseJustPressed && g.hoveredMenu == 0 && !g.dailyPlayed && g.userID > 0 {
+		g.startDaily()
+	}
+}
@@ -0,0 +1,357 @@
+package ui
+
+import (
+	"image"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/RALPH22222/Broadside/game"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// Fleet ships are drawn smaller so up to four fit left of the enemy
+const (
+	fleetShipW   = 120
+	fleetShipH   = 84
+	fleetShipGap = 10
+)
+
+func fleetNameRect(i int) image.Rectangle {
+	return image.Rect(practiceX, 170+i*90, practiceX+440, 218+i*90)
+}
+
+func fleetSubjectRect(i int) image.Rectangle {
+	return image.Rect(practiceX+460, 170+i*90, practiceX+practiceW, 218+i*90)
+}
+
+// fleetSeatRect is the toggle above a name box that hands the ship to a computer captain
+func fleetSeatRect(i int) image.Rectangle {
+	return image.Rect(practiceX+130, 142+i*90, practiceX+440, 168+i*90)
+}
+
+// openFleetSetup shows the form where the captains name their ships and pick subjects.
+func (g *Game) openFleetSetup() {
+	if len(g.subjects) == 0 {
+		g.subjects = g.quiz.ListSubjects()
+	}
+	if len(g.fleetNames) == 0 {
+		g.fleetNames = []string{g.playerName, ""}
+		g.fleetSubjects = []int{0, 0}
+		g.fleetCaptains = []int{-1, -1}
+	}
+	g.fleetField = 0
+	g.fleetMsg = ""
+	g.hoveredMenu = -1
+	g.state = StateFleetSetup
+}
+
+func (g *Game) drawFleetSetup(screen *ebiten.Image) {
+	drawWrappedTextWithShadow(screen, "Fleet Battle", g.gameFont, 40, 60, ScreenWidth-80, 36, VictoryGold)
+	drawWrappedTextWithShadow(screen, "Each captain answers questions from their own subject. Hits add up against one big enemy; a miss only hurts your own ship.", g.confirmFont, 40, 100, ScreenWidth-80, 24, SmokeWhite)
+	for i, name := range g.fleetNames {
+		r := fleetNameRect(i)
+		border := NavyBlue
+		if g.fleetField == i {
+			border = OceanTeal
+			if (time.Now().UnixNano()/500_000_000)%2 == 0 {
+				name += "|"
+			}
+		}
+		vector.DrawFilledRect(screen, float32(r.Min.X), float32(r.Min.Y), float32(r.Dx()), float32(r.Dy()), SmokeWhite, true)
+		vector.StrokeRect(screen, float32(r.Min.X), float32(r.Min.Y), float32(r.Dx()), float32(r.Dy()), 3, border, true)
+		drawWrappedTextWithShadow(screen, "Captain "+itoa(i+1), g.confirmFont, r.Min.X, r.Min.Y-6, r.Dx(), 24, VictoryGold)
+		seat := fleetSeatRect(i)
+		drawWrappedTextWithShadow(screen, seatLabel(g.fleetCaptains[i]), g.confirmFont, seat.Min.X, r.Min.Y-6, seat.Dx(), 24, OceanTeal)
+		drawWrappedTextWithShadow(screen, name, g.gameFont, r.Min.X+12, r.Min.Y+36, r.Dx()-24, 36, NavyBlue)
+
+		sr := fleetSubjectRect(i)
+		vector.DrawFilledRect(screen, float32(sr.Min.X), float32(sr.Min.Y), float32(sr.Dx()), float32(sr.Dy()), GunmetalGray, true)
+		vector.StrokeRect(screen, float32(sr.Min.X), float32(sr.Min.Y), float32(sr.Dx()), float32(sr.Dy()), 3, VictoryGold, true)
+		drawWrappedTextWithShadow(screen, "Subject (click to change)", g.confirmFont, sr.Min.X, sr.Min.Y-6, sr.Dx(), 24, VictoryGold)
+		drawWrappedTextWithShadow(screen, g.subjects[g.fleetSubjects[i]], g.gameFont, sr.Min.X+12, sr.Min.Y+36, sr.Dx()-24, 36, SmokeWhite)
+	}
+	drawWrappedTextWithShadow(screen, g.fleetMsg, g.confirmFont, practiceX, 560, practiceW, 24, AlertRed)
+	n := len(g.fleetNames)
+	g.drawButtonRow(screen, []string{"- Ship", "+ Ship", "Start", "Back"}, []bool{n <= game.FleetMinShips, n >= game.FleetMaxShips})
+}
+
+func (g *Game) updateFleetSetup(mouseJustPressed bool) {
+	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+		g.state = StateMultiplayerSelect
+		return
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyTab) {
+		g.fleetField = (g.fleetField + 1) % len(g.fleetNames)
+	}
+	// A computer captain's name comes with its personality
+	if g.fleetCaptains[g.fleetField] < 0 {
+		editText(&g.fleetNames[g.fleetField], 16)
+	}
+	x, y := ebiten.CursorPosition()
+	g.hoveredMenu = hitRect(g.buttonRects, x, y)
+	if !mouseJustPressed {
+		return
+	}
+	for i := range g.fleetNames {
+		if image.Pt(x, y).In(fleetNameRect(i)) {
+			g.fleetField = i
+		}
+		if image.Pt(x, y).In(fleetSubjectRect(i)) {
+			g.fleetSubjects[i] = (g.fleetSubjects[i] + 1) % len(g.subjects)
+		}
+		if image.Pt(x, y).In(fleetSeatRect(i)) {
+			g.fleetCaptains[i] = nextCaptain(g.fleetCaptains[i])
+			g.fleetNames[i] = ""
+			if g.fleetCaptains[i] >= 0 {
+				g.fleetNames[i] = game.Personalities[g.fleetCaptains[i]].Name
+			}
+		}
+	}
+	n := len(g.fleetNames)
+	switch g.hoveredMenu {
+	case 0:
+		if n > game.FleetMinShips {
+			g.fleetNames, g.fleetSubjects, g.fleetCaptains = g.fleetNames[:n-1], g.fleetSubjects[:n-1], g.fleetCaptains[:n-1]
+			if g.fleetField >= n-1 {
+				g.fleetField = n - 2
+			}
+		}
+	case 1:
+		if n < game.FleetMaxShips {
+			g.fleetNames = append(g.fleetNames, "")
+			g.fleetSubjects = append(g.fleetSubjects, 0)
+			g.fleetCaptains = append(g.fleetCaptains, -1)
+		}
+	case 2:
+		g.confirmFleet()
+	case 3:
+		g.state = StateMultiplayerSelect
+	}
+}
+
+// confirmFleet checks the captains' names, saves them and moves on to the difficulty.
+func (g *Game) confirmFleet() {
+	seen := map[string]bool{}
+	for i, name := range g.fleetNames {
+		name = strings.TrimSpace(name)
+		switch {
+		case name == "":
+			g.fleetField = i
+			g.fleetMsg = "Every captain needs a name."
+			return
+		case seen[strings.ToLower(name)]:
+			g.fleetField = i
+			g.fleetMsg = "Pick different names."
+			return
+		}
+		seen[strings.ToLower(name)] = true
+		g.fleetNames[i] = name
+	}
+	g.fleetIDs = make([]int64, len(g.fleetNames))
+	for i, name := range g.fleetNames {
+		if g.fleetCaptains[i] >= 0 {
+			continue
+		}
+		if name == g.playerName && g.userID > 0 {
+			g.fleetIDs[i] = g.userID
+			continue
+		}
+		id, err := game.SeatAccount(g.store, name)
+		if err != nil {
+			log.Printf("failed to save user: %v", err)
+			continue
+		}
+		g.fleetIDs[i] = id
+	}
+	g.mode = game.ModeFleet
+	g.hoveredMenu = -1
+	g.state = StateSelectDifficulty
+}
+
+// startFleet starts the battle; every ship draws its own questions from its subject.
+func (g *Game) startFleet(difficulty string) {
+	g.startCombat(nil, difficulty)
+	subjects := make([]string, len(g.fleetNames))
+	for i, s := range g.fleetSubjects {
+		subjects[i] = g.subjects[s]
+	}
+	g.fleet = game.NewFleet(g.fleetNames, g.fleetIDs, subjects, difficulty)
+	for i, c := range g.fleetCaptains {
+		if c >= 0 {
+			g.fleet.Ships[i].Seat = newCaptain(c)
+		}
+	}
+	g.selectedDifficulty = difficulty
+	g.selectedSubject = "Fleet"
+	g.fleetQueues = make([][]QuizQuestion, len(subjects))
+	g.fleetNext = make([]int, len(subjects))
+	for i, subject := range subjects {
+		for _, q := range g.quiz.SelectQuestions([]string{subject}, difficulty, g.fleet.Questions) {
+			g.fleetQueues[i] = append(g.fleetQueues[i], QuizQuestion{
+				Question: q.Text,
+				Options:  q.Choices,
+				Answer:   q.AnswerIndex(),
+				Hint:     q.Hint,
+				Subject:  q.Subject,
+			})
+		}
+	}
+	g.quizQuestions = nil
+	g.pushFleetQuestion()
+	g.bonusQIndex = -1
+	g.syncFleet()
+	g.state = StatePlaying
+}
+
+// pushFleetQuestion queues the next question of the ship whose turn it is. A small
+// subject bank starts over rather than running dry.
+func (g *Game) pushFleetQuestion() {
+	queue := g.fleetQueues[g.fleet.Turn]
+	if len(queue) == 0 {
+		return
+	}
+	next := g.fleetNext[g.fleet.Turn]
+	g.fleetNext[g.fleet.Turn]++
+	g.quizQuestions = append(g.quizQuestions, queue[next%len(queue)])
+}
+
+// syncFleet mirrors the team onto the battle fields the shared battle code reads.
+func (g *Game) syncFleet() {
+	f := g.fleet
+	g.playerHP = 0
+	for _, s := range f.Ships {
+		g.playerHP += s.HP
+	}
+	g.enemyMaxHP, g.enemyHP = f.EnemyMaxHP, f.EnemyHP
+	g.score = f.TeamScore()
+	g.combatOver = f.Over()
+}
+
+// fleetActionText describes the answer of the ship that just answered.
+func (g *Game) fleetActionText(cs game.CombatState) string {
+	name := g.fleet.Ships[g.fleet.LastShip].Name
+	if g.fleetHit > 0 {
+		return name + " hits for " + itoa(g.fleetHit) + "!"
+	}
+	if cs.EnemyShots > 0 {
+		return name + " takes fire!"
+	}
+	return ""
+}
+
+// finishFleet records the team result and shows it.
+func (g *Game) finishFleet() {
+	saved := true
+	for _, id := range g.fleetIDs {
+		saved = saved && id > 0
+	}
+	if saved {
+		if err := g.store.InsertFleetResult(g.fleet, g.selectedDifficulty); err != nil {
+			log.Printf("failed to save fleet result: %v", err)
+		}
+	}
+	g.showFeedback = false
+	g.selectedAns = -1
+	g.hoveredMenu = -1
+	g.state = StateFleetResults
+}
+
+// drawFleetHUD lists every ship on the left and the enemy and team score on the right.
+func (g *Game) drawFleetHUD(screen *ebiten.Image, barY int) {
+	f := g.fleet
+	for i, s := range f.Ships {
+		col := SmokeWhite
+		switch {
+		case s.HP == 0:
+			col = GunmetalGray
+		case i == f.Turn && !g.showFeedback:
+			col = VictoryGold
+		}
+		line := s.Name + ": HP " + itoa(s.HP) + " Sh " + itoa(s.Shields) + " - " + itoa(s.Score) + " pts"
+		drawWrappedTextWithShadow(screen, line, g.confirmFont, 40, barY+i*28, 420, 24, col)
+	}
+	drawWrappedTextWithShadow(screen, "Enemy HP: "+itoa(f.EnemyHP)+"/"+itoa(f.EnemyMaxHP), g.gameFont, ScreenWidth-380, barY, 360, 36, AlertRed)
+	drawWrappedTextWithShadow(screen, "Team: "+itoa(f.TeamScore())+" pts", g.gameFont, ScreenWidth-380, barY+40, 360, 36, VictoryGold)
+	streak := f.Ships[f.Turn].Streak
+	drawWrappedTextWithShadow(screen, "Combo: "+itoa(streak)+" (x"+formatMultiplier(game.ComboMultiplier(streak))+")", g.gameFont, ScreenWidth-380, barY+80, 360, 36, SmokeWhite)
+}
+
+// fleetShipX is the left edge of ship i of the fleet
+func fleetShipX(i int) int {
+	return 20 + i*(fleetShipW+fleetShipGap)
+}
+
+// drawFleetShips draws the fleet in a row left of the enemy, with the shots of the
+// ship that answered last.
+func (g *Game) drawFleetShips(screen *ebiten.Image) {
+	f := g.fleet
+	shipY := ScreenHeight - 60 - fleetShipH
+	for i, s := range f.Ships {
+		x := fleetShipX(i)
+		if frame := g.playerShipFrames[playerFrameIndex(s.HP, g.playerMaxHP)]; frame != nil {
+			op := &ebiten.DrawImageOptions{}
+			op.GeoM.Scale(float64(fleetShipW)/64.0, float64(fleetShipH)/64.0)
+			op.GeoM.Translate(float64(x), float64(shipY))
+			screen.DrawImage(frame, op)
+		}
+		col := SmokeWhite
+		if i == f.Turn {
+			col = VictoryGold
+			vector.DrawFilledCircle(screen, float32(x+fleetShipW/2), float32(shipY-36), 5, VictoryGold, true)
+		}
+		drawWrappedTextWithShadow(screen, s.Name, g.confirmFont, x, shipY-8, fleetShipW+fleetShipGap, 24, col)
+	}
+	if !g.showFire {
+		return
+	}
+	img := g.fireImgPlayer
+	if g.fireType == 2 {
+		img = g.fireImgEnemy
+	}
+	fromX := fleetShipX(f.LastShip) + fleetShipW
+	fireW := ScreenWidth - 200 - 60 - fromX
+	if img == nil || fireW <= 0 {
+		return
+	}
+	fireH := 60
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Scale(float64(fireW)/float64(img.Bounds().Dx()), float64(fireH)/float64(img.Bounds().Dy()))
+	op.GeoM.Translate(float64(fromX), float64(shipY+fleetShipH/2-fireH/2))
+	screen.DrawImage(img, op)
+}
+
+func (g *Game) drawFleetResults(screen *ebiten.Image) {
+	f := g.fleet
+	headline := "The enemy escaped."
+	switch {
+	case f.Victory():
+		headline = "Victory! The fleet sank the " + game.GetEnemyClass(f.Level).Name + "."
+	case g.playerHP == 0:
+		headline = "The whole fleet was sunk."
+	}
+	drawWrappedTextWithShadow(screen, headline, g.gameFont, ScreenWidth/10, ScreenHeight/8+40, ScreenWidth*8/10, 36, VictoryGold)
+	drawWrappedTextWithShadow(screen, "Team score: "+itoa(f.TeamScore()), g.gameFont, ScreenWidth/10, ScreenHeight/8+90, ScreenWidth*8/10, 36, SmokeWhite)
+	for i, s := range f.Ships {
+		line := s.Name + " (" + s.Subject + "): " + itoa(s.Score) + " pts, " + itoa(s.Correct) + "/" + itoa(s.Answered) + " correct, " + itoa(s.Damage) + " damage, hull " + itoa(s.HP)
+		drawWrappedTextWithShadow(screen, line, g.confirmFont, ScreenWidth/10, ScreenHeight/8+150+i*32, ScreenWidth*8/10, 24, SmokeWhite)
+	}
+	g.drawButtonRow(screen, []string{"Rematch", "Main Menu"}, nil)
+}
+
+func (g *Game) updateFleetResults(mouseJustPressed bool) {
+	x, y := ebiten.CursorPosition()
+	g.hoveredMenu = hitRect(g.buttonRects, x, y)
+	if !mouseJustPressed {
+		return
+	}
+	switch g.hoveredMenu {
+	case 0:
+		g.startFleet(g.selectedDifficulty)
+	case 1:
+		g.mode = game.ModeStandard
+		g.state = StateMenu
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"image"
 	"image/color"
 	"math"
-	"math/rand"
 	"os"
 	"strings"
 	"time"
@@ -12,6 +11,7 @@ import (
 	"log"
 
 	"github.com/RALPH22222/Broadside/game"
+	"github.com/RALPH22222/Broadside/netplay"
 
 	"unicode/utf8"
 
@@ -21,6 +21,7 @@ import (
 	"fmt"
 
 	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
 	"github.com/hajimehoshi/ebiten/v2/text"
 	"github.com/hajimehoshi/ebiten/v2/vector"
 	"golang.org/x/image/font"
@@ -46,7 +47,11 @@ var (
 type QuizQuestion struct {
 	Question string
 	Options  []string
-	Answer   int // index of correct answer
+	Answer   int    // index of correct answer
+	Hint     string // optional hint, "" if the author wrote none
+	Subject  string
+	// Explanation of the answer shown in practice mode, "" if the author wrote none
+	Explanation string
 }
 
 // Level constants
@@ -61,9 +66,11 @@ var levelNames = []string{"Easy", "Medium", "Hard", "Extreme"}
 
 // Game represents the main game state
 type Game struct {
+	store       game.Store
 	gameFont    font.Face
 	state       GameState
 	menuRects   []image.Rectangle // clickable menu option areas
+	buttonRects []image.Rectangle // buttons along the bottom of full-screen mode screens
 	hoveredMenu int               // -1 if none
 
 	// Quiz game state
@@ -117,6 +124,8 @@ type Game struct {
 	// Add for slicing enemy ship
 	playerShipFrames [4]*ebiten.Image
 	enemyShipFrames  [4]*ebiten.Image
+	// Per-class enemy sprites, indexed by level; nil entries fall back to enemyShipFrames
+	enemyClassFrames [4][4]*ebiten.Image
 
 	// Add userID to Game struct
 	userID int64
@@ -134,6 +143,7 @@ type Game struct {
 	starModalResult int               // 0: undecided, 1: continue, 2: exit
 
 	answeredSubjects map[string]bool // key: subject, value: answered for current difficulty
+	progress         *game.Progress  // the signed-in captain's completions, kept across sessions
 
 	// Timer for question answering
 	questionTimer    time.Time
@@ -163,6 +173,176 @@ type Game struct {
 	showFire      bool
 	fireStartTime time.Time
 	fireType      int // 0: none, 1: player fire, 2: enemy fire
+
+	// Speed scoring for the last answer and the whole battle
+	lastPoints       int
+	lastSpeedBonus   int
+	lastResponseTime time.Duration
+	speedBonusTotal  int
+	answerRecords    []game.AnswerRecord
+
+	// Combo state: current streak, best streak this battle and the HUD announcement
+	streak          int
+	longestStreak   int
+	comboBonusTotal int
+	comboFlashText  string
+	comboFlashTime  time.Time
+	comboFlashCol   color.RGBA
+
+	// Enemy class intro card and the last special attack
+	showEnemyIntro  bool
+	enemyIntroTime  time.Time
+	lastEnemyAction string
+
+	// Boss battle guarding the next difficulty tier
+	boss       game.Boss
+	bossFrames [4]*ebiten.Image
+	bossPhase  int
+	bossTurn   int
+
+	// Practice mode: no HP, shields or timer, and the answer is explained after each question
+	practice         *game.PracticeSession
+	practiceAnswered bool
+
+	// Survival mode: enemy waves keep coming until the player is sunk
+	survivalSubjects []string
+	survivalWaves    int
+	survivalEntries  []game.SurvivalEntry
+	timeAttackTop    []game.TimeAttackEntry
+	leaderboardBoard int // which board the leaderboard overlay shows
+	// the battle board ranks skill ratings instead of single-battle scores
+	leaderboardByRating bool
+	ratingTop           []game.RatingEntry
+	profileRatings      []game.Rating
+	profileScore        int
+
+	// The battle board's view tab and filters; an empty filter matches all
+	leaderboardView       int
+	leaderboardSubject    string
+	leaderboardDifficulty string
+	personalBests         []game.PersonalBest
+	leaderboardNote       string // why the view is empty, if it has to be
+	// The battle board is scrolled a page at a time from the store
+	leaderboardQuery  *game.LeaderboardQuery // nil when the view has nothing to page
+	leaderboardOffset int
+	leaderboardTotal  int
+	leaderboardWheel  float64 // mouse wheel movement short of a whole row
+	// where the player's best row ranks, pinned to the bottom when out of view
+	leaderboardRank int
+	leaderboardBest game.LeaderboardEntry
+
+	// Sign in: returning players pick their captain, optionally behind a PIN
+	accounts    []game.Account
+	accountStep int
+	accountPick int // the captain whose PIN is asked for
+	pinInput    string
+	accountMsg  string
+
+	// Time attack: one global countdown instead of a timer per question
+	timeAttack          *game.TimeAttackRun
+	timeAttackFlash     string
+	timeAttackFlashTime time.Time
+	timeAttackFlashCol  color.RGBA
+
+	// Daily challenge
+	daily        game.DailyChallenge
+	dailyPlayed  bool
+	dailyHistory []game.DailyResult
+	dailyTop     []game.LeaderboardEntry
+	dailyMsg     string
+
+	// Hot-seat versus: two named players take turns on one machine
+	versus            *game.VersusMatch
+	versusNames       [2]string
+	versusIDs         [2]int64
+	versusField       int // name box being typed into
+	versusMsg         string
+	versusShieldsLost int // effect of the last shot
+	versusDamage      int
+	versusCaptain     int // computer personality in player 2's seat, -1 for a person
+
+	// Buzzer party: up to four players race to answer the same question
+	party         *game.PartyGame
+	partyInput    *partyInput
+	partyRevealed bool
+	partyClock    time.Time // when the buzzers opened or the buzzer was taken
+	partyMsg      string
+	partyPlans    []captainPlan // computer seats' answers to the current question
+	// when the current question went up; computer seats time their buzz from it
+	partyQuestionTime time.Time
+
+	// LAN duel and LAN fleet: a client of a match server started with `broadside serve`
+	lanClient       *netplay.Client
+	lanFleet        bool // a co-op fleet on a `broadside serve -fleet` server
+	lanSubject      int  // index into subjects of our ship's subject in a fleet
+	lanAddr         string
+	lanMsg          string
+	lanYou          int // our player index on the server
+	lanPlayers      []netplay.PlayerState
+	lanQuestion     netplay.Message // Round is -1 before the first question
+	lanQuestionTime time.Time
+	lanResult       *netplay.Message // result of the current question, once in
+	lanOver         *netplay.Message
+	lanEnemyHP      int // the fleet's enemy
+	lanEnemyMaxHP   int
+
+	// Classroom sessions: the teacher's machine hosts, students join with the room code
+	classroom         *netplay.Classroom
+	classSaved        bool
+	classMsg          string
+	classJoin         [3]string // name, room code and address typed in the join form
+	classField        int
+	classClient       *netplay.Client
+	classJoined       bool
+	classMe           netplay.PlayerState
+	classFlagship     int
+	classFlagshipMax  int
+	classQuestion     netplay.Message // Round is -1 before the first question
+	classQuestionTime time.Time
+	classResult       *netplay.Message
+	classOver         *netplay.Message
+
+	// Co-op fleet: two to four ships share one enemy, each with its own subject
+	fleet         *game.Fleet
+	fleetNames    []string
+	fleetSubjects []int // index into subjects per ship
+	fleetIDs      []int64
+	fleetField    int
+	fleetMsg      string
+	fleetQueues   [][]QuizQuestion // questions per ship
+	fleetNext     []int            // next question per ship
+	fleetHit      int              // damage of the last answer
+	fleetCaptains []int            // computer personality per ship, -1 for a person
+	fleetTop      []game.FleetEntry
+
+	// The computer captain's answer to the current question of a turn-based battle
+	captainPlanned bool
+	captainChoice  int
+	captainDelay   time.Duration
+
+	// Power-ups: inventory carried between battles and their effect on the current question
+	powerUps         *game.PowerUps
+	powerUpRects     []image.Rectangle
+	removedChoices   map[int]bool // choices taken away by 50/50 or a second chance
+	extraTime        time.Duration
+	secondChance     bool // armed: the next wrong answer is forgiven
+	questionPowerUps []game.PowerUp
+	powerUpMsg       string
+	powerUpMsgTime   time.Time
+
+	// Hint for the current question
+	hintRevealed bool
+	hintRect     image.Rectangle
+
+	// Battle mode and campaign state
+	mode              game.Mode
+	campaigns         []game.Campaign
+	campaign          *game.Campaign
+	campaignRun       *game.CampaignRun
+	campaignNode      *game.CampaignNode // battle node being fought
+	campaignNodeRects []image.Rectangle  // clickable chart nodes, same order as campaign.Nodes
+	hoveredNode       int
+	campaignMsg       string
 }
 
 // GameState represents the current state of the game UI
@@ -179,6 +359,31 @@ const (
 	StateHowToPlay
 	StateLeaderboard
 	StateStarModal
+	StateCampaignSelect
+	StateCampaignMap
+	StatePractice
+	StatePracticeResults
+	StateModeSelect
+	StateSurvivalSelect
+	StateSurvivalResults
+	StateTimeAttack
+	StateTimeAttackResults
+	StateDaily
+	StateVersusNames
+	StateVersusResults
+	StatePartyLobby
+	StateParty
+	StatePartyPodium
+	StateLanConnect
+	StateLanDuel
+	StateClassHost
+	StateClassJoin
+	StateClassStudent
+	StateFleetSetup
+	StateFleetResults
+	StateMultiplayerSelect
+	StateProfile
+	StateAccount
 )
 
 var whiteImg *ebiten.Image
@@ -268,6 +473,56 @@ func (g *Game) Draw(screen *ebiten.Image) {
 		g.drawStarModal(screen)
 	case StatePlaying:
 		g.drawPlaying(screen)
+	case StateCampaignSelect:
+		g.drawCampaignSelect(screen)
+	case StateCampaignMap:
+		g.drawCampaignMap(screen)
+	case StatePractice:
+		g.drawPractice(screen)
+	case StatePracticeResults:
+		g.drawPracticeResults(screen)
+	case StateModeSelect:
+		g.drawModeSelect(screen)
+	case StateSurvivalSelect:
+		g.drawSurvivalSelect(screen)
+	case StateSurvivalResults:
+		g.drawSurvivalResults(screen)
+	case StateTimeAttack:
+		g.drawTimeAttack(screen)
+	case StateTimeAttackResults:
+		g.drawTimeAttackResults(screen)
+	case StateDaily:
+		g.drawDaily(screen)
+	case StateVersusNames:
+		g.drawVersusNames(screen)
+	case StateVersusResults:
+		g.drawVersusResults(screen)
+	case StatePartyLobby:
+		g.drawPartyLobby(screen)
+	case StateParty:
+		g.drawParty(screen)
+	case StatePartyPodium:
+		g.drawPartyPodium(screen)
+	case StateLanConnect:
+		g.drawLanConnect(screen)
+	case StateLanDuel:
+		g.drawLanDuel(screen)
+	case StateClassHost:
+		g.drawClassHost(screen)
+	case StateClassJoin:
+		g.drawClassJoin(screen)
+	case StateClassStudent:
+		g.drawClassStudent(screen)
+	case StateFleetSetup:
+		g.drawFleetSetup(screen)
+	case StateFleetResults:
+		g.drawFleetResults(screen)
+	case StateMultiplayerSelect:
+		g.drawMultiplayerSelect(screen)
+	case StateProfile:
+		g.drawProfile(screen)
+	case StateAccount:
+		g.drawAccount(screen)
 	}
 
 	// Show feedback prominently in the center of the screen when active
@@ -279,12 +534,26 @@ func (g *Game) Draw(screen *ebiten.Image) {
 			col = AlertRed // Use AlertRed for time's up (same as incorrect)
 		} else if g.feedbackRight {
 			msg = "Correct!"
+			if g.lastPoints > 0 || g.hintRevealed {
+				msg += " +" + itoa(g.lastPoints) + " pts"
+				if g.hintRevealed {
+					msg += " (hint -" + itoa(game.HintCost(g.level)) + ")"
+				}
+				if g.lastSpeedBonus > 0 {
+					msg += " +" + itoa(g.lastSpeedBonus) + " speed"
+				}
+				msg += " (" + formatSeconds(g.lastResponseTime) + ")"
+			}
 			col = VictoryGold // Use VictoryGold for correct
 		} else {
 			msg = "Incorrect!"
 			col = AlertRed
 		}
 
+		if g.lastEnemyAction != "" {
+			msg += " " + g.lastEnemyAction
+		}
+
 		feedbackFontSize := 32 // Smaller font
 		bounds, _ := font.BoundString(g.gameFont, msg)
 		msgWidth := (bounds.Max.X - bounds.Min.X).Ceil()
@@ -473,8 +742,12 @@ func (g *Game) drawMenu(screen *ebiten.Image) {
 
 	options := []string{
 		"Play",
+		"Campaign",
+		"Practice",
+		"Game Modes",
 		"How to Play",
 		"Leaderboard",
+		"Profile",
 		"Exit",
 	}
 	g.menuRects = g.menuRects[:0]
@@ -540,10 +813,7 @@ func (g *Game) drawSelectDifficulty(screen *ebiten.Image) {
 		btnY := startY + i*(menuH+ScreenHeight/48)
 		w := menuW
 		h := menuH
-		locked := false
-		if diff != "Easy" && !g.unlockedDifficulties[diff] {
-			locked = true
-		}
+		locked := g.difficultyLocked(diff)
 		glowColor := OceanTeal
 		if g.hoveredMenu == i && !locked {
 			glowColor = VictoryGold
@@ -581,9 +851,41 @@ func (g *Game) drawSelectDifficulty(screen *ebiten.Image) {
 		drawWrappedTextWithShadow(screen, diff, g.gameFont, strX, strY, width, 36, textCol)
 		rect := image.Rect(menuX, btnY, menuX+w, btnY+h)
 		g.menuRects = append(g.menuRects, rect)
+		g.drawDifficultyMark(screen, diff, rect)
 	}
 }
 
+// drawButtonRow draws a row of buttons along the bottom of the screen and saves their clickable areas.
+func (g *Game) drawButtonRow(screen *ebiten.Image, labels []string, disabled []bool) {
+	const gap = 24
+	rowX, rowW := 112, ScreenWidth-224
+	btnW := (rowW - gap*(len(labels)-1)) / len(labels)
+	g.buttonRects = g.buttonRects[:0]
+	for i, label := range labels {
+		x := rowX + i*(btnW+gap)
+		rect := image.Rect(x, ScreenHeight-100, x+btnW, ScreenHeight-52)
+		off := i < len(disabled) && disabled[i]
+		col, textCol := OceanTeal, SmokeWhite
+		if off {
+			col, textCol = GunmetalGray, GunmetalGray
+		} else if g.hoveredMenu == i {
+			col = VictoryGold
+		}
+		vector.DrawFilledRect(screen, float32(rect.Min.X), float32(rect.Min.Y), float32(rect.Dx()), float32(rect.Dy()), color.RGBA{0, 31, 63, 200}, true)
+		vector.StrokeRect(screen, float32(rect.Min.X), float32(rect.Min.Y), float32(rect.Dx()), float32(rect.Dy()), 3, col, true)
+		bounds, _ := font.BoundString(g.gameFont, label)
+		labelW := (bounds.Max.X - bounds.Min.X).Ceil()
+		drawWrappedTextWithShadow(screen, label, g.gameFont, rect.Min.X+(rect.Dx()-labelW)/2, rect.Min.Y+33, rect.Dx(), 36, textCol)
+		g.buttonRects = append(g.buttonRects, rect)
+	}
+}
+
+// difficultyLocked reports whether a difficulty is still locked. Only the standard
+// game follows the unlock order; practice and warm-up modes can pick any tier.
+func (g *Game) difficultyLocked(diff string) bool {
+	return g.mode == game.ModeStandard && diff != "Easy" && !g.unlockedDifficulties[diff]
+}
+
 func (g *Game) drawSelectSubject(screen *ebiten.Image) {
 	msg := "Select Subject"
 	drawWrappedTextWithShadow(screen, msg, g.gameFont, ScreenWidth/10, ScreenHeight/8, ScreenWidth*8/10, 36, VictoryGold)
@@ -632,6 +934,61 @@ func (g *Game) drawSelectSubject(screen *ebiten.Image) {
 		drawWrappedTextWithShadow(screen, subj, g.gameFont, strX, strY, width, 36, textCol)
 		rect := image.Rect(menuX, btnY, menuX+w, btnY+h)
 		g.menuRects = append(g.menuRects, rect)
+		g.drawSubjectMark(screen, subj, rect)
+	}
+}
+
+// drawMenuButtons draws a titled column of menu buttons and saves their clickable areas
+// in g.menuRects. Disabled buttons are grayed out and never highlighted.
+func (g *Game) drawMenuButtons(screen *ebiten.Image, title string, options []string, disabled []bool) {
+	drawWrappedTextWithShadow(screen, title, g.gameFont, ScreenWidth/10, ScreenHeight/8, ScreenWidth*8/10, 36, VictoryGold)
+
+	g.menuRects = g.menuRects[:0]
+	menuW := ScreenWidth * 5 / 12
+	menuX := (ScreenWidth - menuW) / 2
+	menuH := ScreenHeight / 14
+	startY := ScreenHeight/2 - (len(options)*menuH+(len(options)-1)*ScreenHeight/48)/2
+	for i, opt := range options {
+		btnY := startY + i*(menuH+ScreenHeight/48)
+		w := menuW
+		h := menuH
+		off := i < len(disabled) && disabled[i]
+		glowColor := OceanTeal
+		if g.hoveredMenu == i && !off {
+			glowColor = VictoryGold
+		}
+		for r := 0; r < 3; r++ {
+			alpha := uint8(60 - r*20)
+			vector.StrokeRect(screen, float32(menuX-r*3), float32(btnY-r*3), float32(w+2*r*3), float32(h+2*r*3), 6, color.RGBA{glowColor.R, glowColor.G, glowColor.B, alpha}, true)
+		}
+		for dy := 0; dy < h; dy++ {
+			frac := float64(dy) / float64(h)
+			c := color.RGBA{
+				R: uint8(47 + 40*frac),
+				G: uint8(79 + 40*frac),
+				B: uint8(79 + 60*frac),
+				A: 255,
+			}
+			if off {
+				c = GunmetalGray
+			}
+			for dx := 0; dx < w; dx++ {
+				screen.Set(menuX+dx, btnY+dy, c)
+			}
+		}
+		vector.StrokeRect(screen, float32(menuX), float32(btnY), float32(w), float32(h), 4, glowColor, true)
+		textCol := SmokeWhite
+		if off {
+			textCol = GunmetalGray
+		} else if g.hoveredMenu == i {
+			textCol = NavyBlue
+		}
+		bounds, _ := font.BoundString(g.gameFont, opt)
+		width := (bounds.Max.X - bounds.Min.X).Ceil()
+		strX := menuX + (w-width)/2
+		strY := btnY + h/2 + 12
+		drawWrappedTextWithShadow(screen, opt, g.gameFont, strX, strY, width, 36, textCol)
+		g.menuRects = append(g.menuRects, image.Rect(menuX, btnY, menuX+w, btnY+h))
 	}
 }
 
@@ -695,6 +1052,19 @@ func (g *Game) drawLeaderboardOverlay(screen *ebiten.Image) {
 
 	// --- Title ---
 	premiumTitle := "LEADERBOARD"
+	switch g.leaderboardBoard {
+	case BoardSurvival:
+		premiumTitle = "SURVIVAL"
+	case BoardTimeAttack:
+		premiumTitle = "TIME ATTACK"
+	case BoardDaily:
+		premiumTitle = "DAILY"
+	case BoardFleet:
+		premiumTitle = "FLEETS"
+	}
+	if g.leaderboardBoard == BoardBattle && g.leaderboardByRating {
+		premiumTitle = "SKILL"
+	}
 	fontSize := 96
 	titleBounds, _ := font.BoundString(fontFace, premiumTitle)
 	titleW := (titleBounds.Max.X - titleBounds.Min.X).Ceil()
@@ -703,22 +1073,24 @@ func (g *Game) drawLeaderboardOverlay(screen *ebiten.Image) {
 	text.Draw(screen, premiumTitle, fontFace, titleX, titleY+fontSize, SmokeWhite)
 
 	// --- Columns ---
-	headers := []string{"Name", "Score"}
+	headers, rows := g.leaderboardRows()
 	const tableInnerPadX = 24
 
 	// Calculate the total usable width for columns within the table's inner padding
 	effectiveTableWidth := leaderW - 2*tableInnerPadX
 
 	// Distribute the effective width to columns
-	col1Width := effectiveTableWidth * 2 / 3
-	col2Width := effectiveTableWidth - col1Width
+	col1Width := effectiveTableWidth / 2
+	col2Width := effectiveTableWidth / 4
+	col3Width := effectiveTableWidth - col1Width - col2Width
 
-	colWidths := []int{col1Width, col2Width}
+	colWidths := []int{col1Width, col2Width, col3Width}
 
 	// colX[j] will now be the left edge of the background rectangle for column j
 	colX := []int{
-		leaderX + tableInnerPadX,             // Left edge of the first column
-		leaderX + tableInnerPadX + col1Width, // Left edge of the second column
+		leaderX + tableInnerPadX,                         // Left edge of the first column
+		leaderX + tableInnerPadX + col1Width,             // Left edge of the second column
+		leaderX + tableInnerPadX + col1Width + col2Width, // Left edge of the third column
 	}
 
 	tableY := leaderY + 120 // Top Y for the text baseline of the first row (header)
@@ -741,6 +1113,8 @@ func (g *Game) drawLeaderboardOverlay(screen *ebiten.Image) {
 			var bgColor color.RGBA
 			if i == 0 {
 				bgColor = color.RGBA{60, 60, 60, 240} // Header background (dark gray)
+			} else if g.isMyLeaderboardRow(i - 1) {
+				bgColor = color.RGBA{120, 95, 20, 230} // The player's own row (gold)
 			} else if (i % 2) == 1 {
 				bgColor = color.RGBA{45, 45, 45, 180} // Slightly lighter dark gray
 			} else {
@@ -754,11 +1128,10 @@ func (g *Game) drawLeaderboardOverlay(screen *ebiten.Image) {
 		var rowVals []string
 		if i == 0 {
 			rowVals = headers
-		} else if i-1 < len(g.leaderboardEntries) {
-			entry := g.leaderboardEntries[i-1]
-			rowVals = []string{strings.TrimSpace(entry.PlayerName), itoa(entry.Score)}
+		} else if i-1 < len(rows) {
+			rowVals = rows[i-1]
 		} else {
-			rowVals = []string{"", ""} // Empty rows if not enough entries
+			rowVals = []string{"", "", ""} // Empty rows if not enough entries
 		}
 
 		for j, val := range rowVals {
@@ -777,7 +1150,7 @@ func (g *Game) drawLeaderboardOverlay(screen *ebiten.Image) {
 	// --- FIX --- Define the exact X coordinates for the left and right of the entire grid area
 	// These must match the boundaries used for drawing cell backgrounds.
 	tableGridLeftX := float32(leaderX + tableInnerPadX)
-	tableGridRightX := float32(leaderX + tableInnerPadX + col1Width + col2Width) // Right edge of the last column
+	tableGridRightX := float32(leaderX + tableInnerPadX + col1Width + col2Width + col3Width) // Right edge of the last column
 
 	// Vertical lines
 	// --- FIX --- Draw each vertical line exactly at the column boundaries
@@ -791,6 +1164,11 @@ func (g *Game) drawLeaderboardOverlay(screen *ebiten.Image) {
 		float32(colX[1]), tableGridBottomY,
 		2, VictoryGold, true)
 
+	vector.StrokeLine(screen,
+		float32(colX[2]), tableGridTopY, // Line between column 2 and 3
+		float32(colX[2]), tableGridBottomY,
+		2, VictoryGold, true)
+
 	vector.StrokeLine(screen,
 		tableGridRightX, tableGridTopY,
 		tableGridRightX, tableGridBottomY,
@@ -809,24 +1187,101 @@ func (g *Game) drawLeaderboardOverlay(screen *ebiten.Image) {
 			2, VictoryGold, true)
 	}
 
+	g.drawLeaderboardScrollbar(screen, tableGridRightX+6, tableGridTopY+float32(lineHeight), tableGridBottomY)
+
 	// --- Footer ---
-	msg2 := "(Press ESC or click to close)"
+	msg2 := "(TAB to switch board, ESC or click to close)"
+	if g.showsLeaderboardTabs() {
+		msg2 = "(TAB board, LEFT/RIGHT view, UP/DOWN scroll, F subject, D difficulty, S skill, ESC)"
+	} else if g.leaderboardBoard == BoardBattle {
+		msg2 = "(TAB switch board, S sort by score/skill, ESC close)"
+	}
 	msg2Bounds, _ := font.BoundString(fontFace, msg2)
 	msg2Width := (msg2Bounds.Max.X - msg2Bounds.Min.X).Ceil()
 	drawWrappedTextWithShadow(screen, msg2, fontFace, (w-msg2Width)/2, h-48, w-48, 32, SmokeWhite)
+	g.drawLeaderboardTabs(screen)
+}
+
+// Boards of the leaderboard overlay
+const (
+	BoardBattle = iota
+	BoardSurvival
+	BoardTimeAttack
+	BoardDaily
+	BoardFleet
+	numBoards
+)
+
+// leaderboardRows returns the column headers and rows of the selected board.
+func (g *Game) leaderboardRows() (headers []string, rows [][]string) {
+	if g.leaderboardBoard == BoardBattle && g.leaderboardByRating {
+		for _, e := range g.ratingTop {
+			rows = append(rows, []string{strings.TrimSpace(e.PlayerName), itoa(e.Rating), e.Subject})
+		}
+		return []string{"Name", "Rating", "Subject"}, rows
+	}
+	switch g.leaderboardBoard {
+	case BoardSurvival:
+		for _, e := range g.survivalEntries {
+			rows = append(rows, []string{strings.TrimSpace(e.PlayerName), itoa(e.Score), itoa(e.Waves)})
+		}
+		return []string{"Name", "Score", "Waves"}, rows
+	case BoardTimeAttack:
+		for _, e := range g.timeAttackTop {
+			rows = append(rows, []string{strings.TrimSpace(e.PlayerName), itoa(e.Score), itoa(e.Correct)})
+		}
+		return []string{"Name", "Score", "Correct"}, rows
+	case BoardDaily:
+		for _, e := range g.dailyTop {
+			rows = append(rows, []string{strings.TrimSpace(e.PlayerName), itoa(e.Score), itoa(e.LongestStreak)})
+		}
+		return []string{"Name", "Score", "Streak"}, rows
+	case BoardFleet:
+		for _, e := range g.fleetTop {
+			result := e.Difficulty
+			if e.EnemySunk {
+				result += " - sunk"
+			}
+			rows = append(rows, []string{e.Members, itoa(e.TeamScore), result})
+		}
+		return []string{"Team", "Score", "Result"}, rows
+	default:
+		return g.battleBoardRows()
+	}
 }
 
 func (g *Game) drawPlaying(screen *ebiten.Image) {
 	// Draw HP, shields, enemy HP
 	barY := 40
-	drawWrappedTextWithShadow(screen, "Your HP: "+itoa(g.playerHP)+"/"+itoa(g.playerMaxHP), g.gameFont, 40, barY, ScreenWidth-200, 36, VictoryGold)
-	drawWrappedTextWithShadow(screen, "Shields: "+itoa(g.playerShields)+"/"+itoa(g.playerMaxShields), g.gameFont, 40, barY+40, ScreenWidth-200, 36, OceanTeal)
-	drawWrappedTextWithShadow(screen, "Enemy HP: "+itoa(g.enemyHP)+"/"+itoa(g.enemyMaxHP), g.gameFont, ScreenWidth-340, barY, ScreenWidth-200, 36, AlertRed)
-	drawWrappedTextWithShadow(screen, "Level: "+levelNames[g.level], g.gameFont, ScreenWidth-340, barY+40, ScreenWidth-200, 36, SmokeWhite)
+	if g.mode == game.ModeVersus {
+		g.drawVersusHUD(screen, barY)
+	} else if g.mode == game.ModeFleet {
+		g.drawFleetHUD(screen, barY)
+	} else {
+		drawWrappedTextWithShadow(screen, "Your HP: "+itoa(g.playerHP)+"/"+itoa(g.playerMaxHP), g.gameFont, 40, barY, ScreenWidth-200, 36, VictoryGold)
+		drawWrappedTextWithShadow(screen, "Shields: "+itoa(g.playerShields)+"/"+itoa(g.playerMaxShields), g.gameFont, 40, barY+40, ScreenWidth-200, 36, OceanTeal)
+		drawWrappedTextWithShadow(screen, "Enemy HP: "+itoa(g.enemyHP)+"/"+itoa(g.enemyMaxHP), g.gameFont, ScreenWidth-340, barY, ScreenWidth-200, 36, AlertRed)
+		if g.mode == game.ModeBoss {
+			g.drawBossHUD(screen, ScreenWidth-340, barY+40)
+		} else {
+			drawWrappedTextWithShadow(screen, "Level: "+levelNames[g.level]+" "+game.GetEnemyClass(g.level).Name, g.gameFont, ScreenWidth-340, barY+40, ScreenWidth-200, 36, SmokeWhite)
+		}
+		comboCol := SmokeWhite
+		if g.streak >= 2 {
+			comboCol = VictoryGold
+		}
+		drawWrappedTextWithShadow(screen, "Combo: "+itoa(g.streak)+" (x"+formatMultiplier(game.ComboMultiplier(g.streak))+")", g.gameFont, ScreenWidth-340, barY+80, ScreenWidth-200, 36, comboCol)
+	}
+	// Announce combo changes for a moment
+	if g.comboFlashText != "" && time.Since(g.comboFlashTime) < 1200*time.Millisecond {
+		bounds, _ := font.BoundString(g.gameFont, g.comboFlashText)
+		flashW := (bounds.Max.X - bounds.Min.X).Ceil()
+		drawWrappedTextWithShadow(screen, g.comboFlashText, g.gameFont, (ScreenWidth-flashW)/2, barY+130, flashW+8, 36, g.comboFlashCol)
+	}
 
 	// Draw timer if active
 	if g.timerActive && !g.showFeedback {
-		remaining := g.questionDuration - time.Since(g.questionTimer)
+		remaining := g.questionDuration + g.extraTime - time.Since(g.questionTimer)
 		if remaining > 0 {
 			seconds := int(remaining.Seconds()) + 1
 			timerColor := SmokeWhite
@@ -840,24 +1295,8 @@ func (g *Game) drawPlaying(screen *ebiten.Image) {
 		} else {
 			// Time's up - handle timeout
 			if g.currentQ < len(g.quizQuestions) {
-				isBonusQ := g.currentQ == 0
-				questionsLeft := len(g.quizQuestions) - g.currentQ - 1
-
 				// Process timeout as incorrect answer
-				cs := game.ProcessAnswer(
-					g.level, g.playerHP, g.playerShields, g.enemyHP, g.score, g.mainQDone, g.bonusActive, g.bonusAnswered,
-					g.bonusQIndex, g.currentQ, isBonusQ, false, questionsLeft,
-				)
-
-				// Update game state
-				g.playerHP = cs.PlayerHP
-				g.playerShields = cs.PlayerShields
-				g.enemyHP = cs.EnemyHP
-				g.score = cs.Score
-				g.mainQDone = cs.MainQDone
-				g.bonusActive = cs.BonusActive
-				g.bonusAnswered = cs.BonusAnswered
-				g.combatOver = cs.CombatOver
+				g.processAnswer(false, true, g.questionDuration+g.extraTime)
 
 				// Show timeout feedback first
 				g.showFeedback = true
@@ -869,6 +1308,13 @@ func (g *Game) drawPlaying(screen *ebiten.Image) {
 		}
 	}
 
+	// Introduce the enemy class before the first question
+	if g.showEnemyIntro {
+		g.drawEnemyIntro(screen)
+		g.drawShips(screen)
+		return
+	}
+
 	// Only show question box and options
 	if g.currentQ < len(g.quizQuestions) {
 		q := g.quizQuestions[g.currentQ]
@@ -904,10 +1350,21 @@ func (g *Game) drawPlaying(screen *ebiten.Image) {
 				totalOptionsHeight += btnGap
 			}
 		}
+		// --- Reserve room for the hint button or the revealed hint ---
+		hintLines := []string(nil)
+		hintHeight := 0
+		if q.Hint != "" {
+			if g.hintRevealed {
+				hintLines = wrapText(g.confirmFont, "Hint: "+q.Hint, questionW)
+				hintHeight = len(hintLines)*22 + btnGap
+			} else {
+				hintHeight = 36 + btnGap
+			}
+		}
 		// --- Calculate total box size and position ---
 		padding := 32
 		boxW := questionW + padding*2
-		boxH := questionHeight + totalOptionsHeight + padding*3 + 16
+		boxH := questionHeight + totalOptionsHeight + hintHeight + padding*3 + 16
 		boxX := (ScreenWidth - boxW) / 2
 		boxY := (ScreenHeight - boxH) / 2
 		// --- Draw the main rectangle ---
@@ -934,11 +1391,18 @@ func (g *Game) drawPlaying(screen *ebiten.Image) {
 			if g.selectedAns == i {
 				bgCol = OceanTeal
 			}
+			borderCol := VictoryGold
+			if g.removedChoices[i] {
+				bgCol = NavyBlue
+				borderCol = GunmetalGray
+			}
 			vector.DrawFilledRect(screen, float32(btnX), float32(btnY), float32(btnW), float32(btnH), bgCol, true)
-			vector.StrokeRect(screen, float32(btnX), float32(btnY), float32(btnW), float32(btnH), 3, VictoryGold, true)
+			vector.StrokeRect(screen, float32(btnX), float32(btnY), float32(btnW), float32(btnH), 3, borderCol, true)
 			textCol := SmokeWhite
 			if g.selectedAns == i {
 				textCol = VictoryGold
+			} else if g.removedChoices[i] {
+				textCol = GunmetalGray
 			}
 			// Vertically center the text block in the button
 			textBlockHeight := len(optLines) * (optionFontSize + 6)
@@ -958,6 +1422,24 @@ func (g *Game) drawPlaying(screen *ebiten.Image) {
 			btnY += btnH + btnGap
 
 		}
+		// --- Draw the hint, or the button that reveals it ---
+		if q.Hint != "" {
+			if g.hintRevealed {
+				for i, line := range hintLines {
+					text.Draw(screen, line, g.confirmFont, btnX, btnY+16+i*22, VictoryGold)
+				}
+				g.hintRect = image.Rectangle{}
+			} else {
+				hintLabel := "Hint [H] (-" + itoa(game.HintCost(g.level)) + " pts)"
+				bounds, _ := font.BoundString(g.confirmFont, hintLabel)
+				hintW := (bounds.Max.X - bounds.Min.X).Ceil() + 24
+				hintX := btnX + btnW - hintW
+				vector.DrawFilledRect(screen, float32(hintX), float32(btnY), float32(hintW), 36, NavyBlue, true)
+				vector.StrokeRect(screen, float32(hintX), float32(btnY), float32(hintW), 36, 2, OceanTeal, true)
+				text.Draw(screen, hintLabel, g.confirmFont, hintX+12, btnY+24, SmokeWhite)
+				g.hintRect = image.Rect(hintX, btnY, hintX+hintW, btnY+36)
+			}
+		}
 	} else if g.currentQ >= len(g.quizQuestions) && !g.combatOver {
 		// Show a placeholder when all questions are answered but combat isn't over yet
 		placeholderMsg := "All questions answered!"
@@ -986,8 +1468,10 @@ func (g *Game) drawPlaying(screen *ebiten.Image) {
 
 	// Draw ships below the question box
 	g.drawShips(screen)
+	g.drawPowerUps(screen)
 
-	if g.combatOver {
+	// Survival runs, versus matches and fleet battles end in Update with their own results screen
+	if g.combatOver && g.mode != game.ModeSurvival && g.mode != game.ModeVersus && g.mode != game.ModeFleet {
 		// Handle unanswered questions based on victory/defeat conditions
 		if g.currentQ < len(g.quizQuestions) {
 			unanswered := len(g.quizQuestions) - g.currentQ
@@ -1028,6 +1512,7 @@ func (g *Game) drawPlaying(screen *ebiten.Image) {
 				stars = 0
 			}
 			g.starCount = stars
+			g.saveStars()
 			// Reset animation state
 			g.starModalStartTime = time.Now()
 			g.starAnimationDone = false
@@ -1040,8 +1525,15 @@ func (g *Game) drawPlaying(screen *ebiten.Image) {
 
 	// After answer, show fire if needed
 	if g.showFeedback && !g.showFire && g.selectedAns != -1 && g.currentQ < len(g.quizQuestions) {
-		isBonusQ := g.currentQ == 0
-		if !isBonusQ {
+		isBonusQ := g.currentQ == g.bonusQIndex
+		if g.mode == game.ModeVersus {
+			// Only hits are fired, from the ship of whoever answered
+			if g.feedbackRight {
+				g.showFire = true
+				g.fireStartTime = time.Now()
+				g.fireType = 1 + g.versus.LastPlayer
+			}
+		} else if !isBonusQ {
 			if g.feedbackRight {
 				g.showFire = true
 				g.fireStartTime = time.Now()
@@ -1061,11 +1553,7 @@ func (g *Game) drawPlaying(screen *ebiten.Image) {
 			// After fire, hide feedback and advance question
 			g.showFeedback = false
 			g.selectedAns = -1
-			g.currentQ++
-			if g.currentQ < len(g.quizQuestions) {
-				g.timerActive = true
-				g.questionTimer = time.Now()
-			}
+			g.advanceQuestion()
 		} else {
 			// Draw ships with fire overlay
 			g.drawShips(screen)
@@ -1113,16 +1601,19 @@ func itoa(n int) string {
 }
 
 // NewGame creates a new Game instance and initializes the font and state.
-func NewGame() *Game {
+func NewGame(store game.Store) *Game {
 	g := &Game{
+		store:                store,
 		state:                StateNameEntry,
 		menuRects:            nil,
 		hoveredMenu:          -1,
 		quiz:                 game.NewQuiz(),
 		unlockedDifficulties: make(map[string]bool),
 		answeredSubjects:     make(map[string]bool),
-		questionDuration:     10 * time.Second, // 10 second timer
+		progress:             game.NewProgress(),
+		questionDuration:     game.QuestionTimeLimit,
 		timerActive:          false,
+		powerUps:             game.NewPowerUps(),
 	}
 	g.initFont()
 	g.initLogo()
@@ -1218,13 +1709,24 @@ func (g *Game) Update() error {
 		if g.hoveredMenu != -1 && mouseJustPressed {
 			switch g.hoveredMenu {
 			case 0: // Play
+				g.mode = game.ModeStandard
+				g.state = StateSelectDifficulty
+			case 1: // Campaign
+				g.openCampaigns()
+			case 2: // Practice
+				g.mode = game.ModePractice
 				g.state = StateSelectDifficulty
-			case 1: // How to Play
+			case 3: // Game Modes
+				g.hoveredMenu = -1
+				g.state = StateModeSelect
+			case 4: // How to Play
 				g.state = StateHowToPlay
-			case 2: // Leaderboard
+			case 5: // Leaderboard
 				g.leaderboardFetched = false // <-- ensure leaderboard always refreshes
 				g.state = StateLeaderboard
-			case 3: // Exit
+			case 6: // Profile
+				g.openProfile()
+			case 7: // Exit
 				os.Exit(0)
 			}
 		}
@@ -1237,11 +1739,7 @@ func (g *Game) Update() error {
 		g.hoveredMenu = -1
 		difficulties := []string{"Easy", "Medium", "Hard", "Extreme"}
 		for i, rect := range g.menuRects {
-			locked := false
-			if difficulties[i] != "Easy" && !g.unlockedDifficulties[difficulties[i]] {
-				locked = true
-			}
-			if locked {
+			if g.difficultyLocked(difficulties[i]) {
 				continue // skip locked difficulties for hover/click
 			}
 			if x >= rect.Min.X && x < rect.Max.X && y >= rect.Min.Y && y < rect.Max.Y {
@@ -1251,7 +1749,30 @@ func (g *Game) Update() error {
 		}
 		if g.hoveredMenu != -1 && mouseJustPressed {
 			g.selectedDifficulty = difficulties[g.hoveredMenu]
-			g.answeredSubjects = make(map[string]bool) // Reset for new difficulty
+			if g.mode == game.ModeTimeAttack {
+				g.startTimeAttack(g.selectedDifficulty)
+				g.prevMousePressed = mousePressed
+				return nil
+			}
+			if g.mode == game.ModeVersus {
+				g.startVersus(g.selectedDifficulty)
+				g.prevMousePressed = mousePressed
+				return nil
+			}
+			if g.mode == game.ModeParty {
+				g.startParty(g.selectedDifficulty)
+				g.prevMousePressed = mousePressed
+				return nil
+			}
+			if g.mode == game.ModeFleet {
+				g.startFleet(g.selectedDifficulty)
+				g.prevMousePressed = mousePressed
+				return nil
+			}
+			// Practice never touches the progress of a difficulty
+			if g.mode != game.ModePractice {
+				g.answeredSubjects = g.progress.Subjects(g.selectedDifficulty)
+			}
 			g.state = StateSelectSubject
 		}
 		g.prevMousePressed = mousePressed
@@ -1271,6 +1792,16 @@ func (g *Game) Update() error {
 			}
 		}
 		if g.hoveredMenu != -1 && mouseJustPressed {
+			if g.mode == game.ModePractice {
+				g.startPractice(g.subjects[g.hoveredMenu], g.selectedDifficulty)
+				g.prevMousePressed = mousePressed
+				return nil
+			}
+			if g.mode == game.ModeClass {
+				g.startClassHost(g.subjects[g.hoveredMenu], g.selectedDifficulty)
+				g.prevMousePressed = mousePressed
+				return nil
+			}
 			g.selectedSubject = g.subjects[g.hoveredMenu]
 			g.startCombatWithSubjectAndDifficulty(g.selectedSubject, g.selectedDifficulty)
 			g.state = StatePlaying
@@ -1278,22 +1809,176 @@ func (g *Game) Update() error {
 		g.prevMousePressed = mousePressed
 		return nil
 	}
+	if g.state == StateModeSelect {
+		g.updateModeSelect(mouseJustPressed)
+		g.prevMousePressed = mousePressed
+		return nil
+	}
+	if g.state == StateSurvivalSelect {
+		g.updateSurvivalSelect(mouseJustPressed)
+		g.prevMousePressed = mousePressed
+		return nil
+	}
+	if g.state == StateTimeAttack {
+		g.updateTimeAttack(mouseJustPressed)
+		g.prevMousePressed = mousePressed
+		return nil
+	}
+	if g.state == StateDaily {
+		g.updateDaily(mouseJustPressed)
+		g.prevMousePressed = mousePressed
+		return nil
+	}
+	if g.state == StateVersusNames {
+		g.updateVersusNames(mouseJustPressed)
+		g.prevMousePressed = mousePressed
+		return nil
+	}
+	if g.state == StateVersusResults {
+		g.updateVersusResults(mouseJustPressed)
+		g.prevMousePressed = mousePressed
+		return nil
+	}
+	if g.state == StatePartyLobby {
+		g.updatePartyLobby(mouseJustPressed)
+		g.prevMousePressed = mousePressed
+		return nil
+	}
+	if g.state == StateParty {
+		g.updateParty(mouseJustPressed)
+		g.prevMousePressed = mousePressed
+		return nil
+	}
+	if g.state == StatePartyPodium {
+		g.updatePartyPodium(mouseJustPressed)
+		g.prevMousePressed = mousePressed
+		return nil
+	}
+	if g.state == StateLanConnect {
+		g.updateLanConnect(mouseJustPressed)
+		g.prevMousePressed = mousePressed
+		return nil
+	}
+	if g.state == StateLanDuel {
+		g.updateLanDuel(mouseJustPressed)
+		g.prevMousePressed = mousePressed
+		return nil
+	}
+	if g.state == StateClassHost {
+		g.updateClassHost(mouseJustPressed)
+		g.prevMousePressed = mousePressed
+		return nil
+	}
+	if g.state == StateClassJoin {
+		g.updateClassJoin(mouseJustPressed)
+		g.prevMousePressed = mousePressed
+		return nil
+	}
+	if g.state == StateClassStudent {
+		g.updateClassStudent(mouseJustPressed)
+		g.prevMousePressed = mousePressed
+		return nil
+	}
+	if g.state == StateMultiplayerSelect {
+		g.updateMultiplayerSelect(mouseJustPressed)
+		g.prevMousePressed = mousePressed
+		return nil
+	}
+	if g.state == StateProfile {
+		g.updateProfile(mouseJustPressed)
+		g.prevMousePressed = mousePressed
+		return nil
+	}
+	if g.state == StateAccount {
+		g.updateAccount(mouseJustPressed)
+		g.prevMousePressed = mousePressed
+		return nil
+	}
+	if g.state == StateFleetSetup {
+		g.updateFleetSetup(mouseJustPressed)
+		g.prevMousePressed = mousePressed
+		return nil
+	}
+	if g.state == StateFleetResults {
+		g.updateFleetResults(mouseJustPressed)
+		g.prevMousePressed = mousePressed
+		return nil
+	}
+	if g.state == StateTimeAttackResults {
+		g.updateTimeAttackResults(mouseJustPressed)
+		g.prevMousePressed = mousePressed
+		return nil
+	}
+	if g.state == StateSurvivalResults {
+		g.updateSurvivalResults(mouseJustPressed)
+		g.prevMousePressed = mousePressed
+		return nil
+	}
+	if g.state == StatePractice {
+		g.updatePractice(mouseJustPressed)
+		g.prevMousePressed = mousePressed
+		return nil
+	}
+	if g.state == StatePracticeResults {
+		g.updatePracticeResults(mouseJustPressed)
+		g.prevMousePressed = mousePressed
+		return nil
+	}
+	if g.state == StateCampaignSelect {
+		g.updateCampaignSelect(mouseJustPressed)
+		g.prevMousePressed = mousePressed
+		return nil
+	}
+	if g.state == StateCampaignMap {
+		g.updateCampaignMap(mouseJustPressed)
+		g.prevMousePressed = mousePressed
+		return nil
+	}
 	// When entering StateLeaderboard, fetch leaderboard entries from the database if not already fetched
 	if g.state == StateLeaderboard && !g.leaderboardFetched {
-		fmt.Println("About to fetch leaderboard entries")
-		entries, err := game.GetTopLeaderboard(10)
-		fmt.Println("After fetch call")
+		g.fetchBattleBoard()
+		survival, err := g.store.GetTopSurvival(10)
 		if err != nil {
-			fmt.Println("Leaderboard DB error:", err)
-		} else {
-			fmt.Printf("Fetched %d leaderboard entries\n", len(entries))
-			for i, e := range entries {
-				fmt.Printf("Fetched Entry %d: %+v\n", i, e)
-				g.leaderboardEntries = entries
-			}
+			log.Printf("failed to load survival leaderboard: %v", err)
+		}
+		g.survivalEntries = survival
+		blitz, err := g.store.GetTopTimeAttack(10)
+		if err != nil {
+			log.Printf("failed to load time-attack leaderboard: %v", err)
 		}
+		g.timeAttackTop = blitz
+		today, err := g.store.GetDailyLeaderboard(g.quiz.NewDailyChallenge(time.Now()).Date, 10)
+		if err != nil {
+			log.Printf("failed to load daily leaderboard: %v", err)
+		}
+		g.dailyTop = today
+		fleets, err := g.store.GetTopFleets(10)
+		if err != nil {
+			log.Printf("failed to load fleet leaderboard: %v", err)
+		}
+		g.fleetTop = fleets
+		ratings, err := g.store.GetTopRatings(10)
+		if err != nil {
+			log.Printf("failed to load rating leaderboard: %v", err)
+		}
+		g.ratingTop = ratings
 		g.leaderboardFetched = true
 	}
+	// TAB cycles through the boards
+	if g.state == StateLeaderboard && inpututil.IsKeyJustPressed(ebiten.KeyTab) {
+		g.leaderboardBoard = (g.leaderboardBoard + 1) % numBoards
+	}
+	// S switches the battle board between best scores and skill ratings
+	if g.state == StateLeaderboard && g.leaderboardBoard == BoardBattle && inpututil.IsKeyJustPressed(ebiten.KeyS) {
+		g.leaderboardByRating = !g.leaderboardByRating
+	}
+	// A click on a view tab or filter picks it instead of closing the overlay
+	if g.state == StateLeaderboard && g.updateLeaderboardTabs(mouseJustPressed) {
+		mouseJustPressed = false
+	}
+	if g.state == StateLeaderboard {
+		g.updateLeaderboardScroll()
+	}
 	if g.state == StateHowToPlay || g.state == StateLeaderboard {
 		if ebiten.IsKeyPressed(ebiten.KeyEscape) || mouseJustPressed {
 			g.state = StateMenu
@@ -1303,33 +1988,44 @@ func (g *Game) Update() error {
 	}
 	// Handle quiz combat
 	if g.state == StatePlaying {
+		// Wait on the enemy intro card; the question timer starts once it is dismissed
+		if g.showEnemyIntro {
+			if mouseJustPressed || ebiten.IsKeyPressed(ebiten.KeySpace) || ebiten.IsKeyPressed(ebiten.KeyEnter) ||
+				time.Since(g.enemyIntroTime) > 5*time.Second || (g.computerTurn() && time.Since(g.enemyIntroTime) > captainIntroTime) {
+				g.showEnemyIntro = false
+				g.timerActive = true
+				g.questionTimer = time.Now()
+			}
+			g.prevMousePressed = mousePressed
+			return nil
+		}
+		// Computer captains answer on their own; the players only watch
+		if g.computerTurn() {
+			g.updateComputerTurn()
+		}
 		// Handle answer option clicks
-		if !g.showFeedback && g.currentQ < len(g.quizQuestions) {
+		if !g.showFeedback && g.currentQ < len(g.quizQuestions) && !g.computerTurn() {
 			x, y := ebiten.CursorPosition()
 			for i, rect := range g.answerRects {
+				if g.removedChoices[i] {
+					continue
+				}
 				if x >= rect.Min.X && x < rect.Max.X && y >= rect.Min.Y && y < rect.Max.Y && mouseJustPressed {
-					g.selectedAns = i
 					// Process the answer
 					q := g.quizQuestions[g.currentQ]
 					isCorrect := i == q.Answer
-					isBonusQ := g.currentQ == 0 // First question is bonus
-					questionsLeft := len(g.quizQuestions) - g.currentQ - 1
+					// A second chance forgives the miss: take the choice away and let them retry
+					if !isCorrect && g.secondChance {
+						g.secondChance = false
+						g.questionPowerUps = append(g.questionPowerUps, game.PowerSecondChance)
+						g.removedChoices[i] = true
+						g.flashPowerUp("Second chance! Try again")
+						break
+					}
+					g.selectedAns = i
 
 					// Use game logic to process answer
-					cs := game.ProcessAnswer(
-						g.level, g.playerHP, g.playerShields, g.enemyHP, g.score, g.mainQDone, g.bonusActive, g.bonusAnswered,
-						g.bonusQIndex, g.currentQ, isBonusQ, isCorrect, questionsLeft,
-					)
-
-					// Update game state
-					g.playerHP = cs.PlayerHP
-					g.playerShields = cs.PlayerShields
-					g.enemyHP = cs.EnemyHP
-					g.score = cs.Score
-					g.mainQDone = cs.MainQDone
-					g.bonusActive = cs.BonusActive
-					g.bonusAnswered = cs.BonusAnswered
-					g.combatOver = cs.CombatOver
+					g.processAnswer(isCorrect, false, time.Since(g.questionTimer))
 
 					// Show feedback first
 					g.showFeedback = true
@@ -1341,21 +2037,54 @@ func (g *Game) Update() error {
 			}
 		}
 
+		// Handle power-up buttons and their 1-4 shortcuts
+		if !g.showFeedback && g.currentQ < len(g.quizQuestions) && !g.computerTurn() {
+			x, y := ebiten.CursorPosition()
+			// Reveal the author's hint for a point cost
+			if !g.hintRevealed && g.quizQuestions[g.currentQ].Hint != "" {
+				if (mouseJustPressed && image.Pt(x, y).In(g.hintRect)) || inpututil.IsKeyJustPressed(ebiten.KeyH) {
+					g.hintRevealed = true
+				}
+			}
+			for i, rect := range g.powerUpRects {
+				clicked := mouseJustPressed && x >= rect.Min.X && x < rect.Max.X && y >= rect.Min.Y && y < rect.Max.Y
+				if clicked || inpututil.IsKeyJustPressed(ebiten.Key1+ebiten.Key(i)) {
+					g.usePowerUp(game.PowerUp(i))
+					break
+				}
+			}
+		}
+
 		// Hide feedback after 2 seconds and move to next question
 		if g.showFeedback && time.Since(g.feedbackTime) > 2*time.Second {
 			g.showFeedback = false
 			g.selectedAns = -1
 
 			// Move to next question
-			g.currentQ++
+			g.advanceQuestion()
+		}
 
-			// Start timer for next question if not at the end
-			if g.currentQ < len(g.quizQuestions) {
-				g.timerActive = true
-				g.questionTimer = time.Now()
+		// Running out of questions ends the battle; results are saved below in the same tick
+		if g.currentQ >= len(g.quizQuestions) {
+			g.combatOver = true
+		}
+		if g.combatOver && g.mode == game.ModeSurvival {
+			g.finishSurvival()
+			g.prevMousePressed = mousePressed
+			return nil
+		}
+		// Versus and fleet battles wait for the last shot to land before showing the results
+		if g.combatOver && (g.mode == game.ModeVersus || g.mode == game.ModeFleet) {
+			if !g.showFeedback {
+				if g.mode == game.ModeVersus {
+					g.finishVersus()
+				} else {
+					g.finishFleet()
+				}
 			}
+			g.prevMousePressed = mousePressed
+			return nil
 		}
-
 		if g.combatOver {
 			// Handle unanswered questions based on victory/defeat conditions
 			if g.currentQ < len(g.quizQuestions) {
@@ -1372,26 +2101,21 @@ func (g *Game) Update() error {
 				}
 			}
 			if !g.showStarModal {
-				// Save score to leaderboard when star modal appears
-				if g.userID > 0 && g.score > 0 {
-					err := game.InsertLeaderboard(
-						g.userID,
-						g.score,
-						g.mainQDone,
-						0,
-						float64(g.scorePercent)/100.0, // accuracy
-						boolToFloat(g.bonusAnswered),  // bonus success
-					)
-					if err != nil {
-						log.Printf("failed to save leaderboard: %v", err)
-					}
+				g.calcRank()
+				g.saveAttempts()
+				g.saveRatings()
+				// Save score to leaderboard when star modal appears; the daily challenge has its own board
+				if g.mode == game.ModeDaily {
+					g.saveDaily()
+				} else if g.userID > 0 && g.score > 0 {
+					g.saveLeaderboard()
 				}
 
 				g.showFeedback = false
 				g.selectedAns = -1
 				g.showStarModal = true
 				g.state = StateStarModal
-				_, percent := game.CalcRankAndPercent(g.score, g.mainQDone, g.bonusAnswered, g.enemyHP, g.playerHP, g.level)
+				percent := g.scorePercent
 				var stars float64
 				switch {
 				case g.playerHP == 0 || percent < 50:
@@ -1410,6 +2134,7 @@ func (g *Game) Update() error {
 					stars = 0
 				}
 				g.starCount = stars
+				g.saveStars()
 				g.starModalStartTime = time.Now()
 				g.starAnimationDone = false
 				g.starPopIndex = 0
@@ -1418,12 +2143,6 @@ func (g *Game) Update() error {
 			g.prevMousePressed = ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft)
 			return nil
 		}
-		if g.currentQ >= len(g.quizQuestions) {
-			g.combatOver = true
-			g.rank, g.scorePercent = game.CalcRankAndPercent(g.score, g.mainQDone, g.bonusAnswered, g.enemyHP, g.playerHP, g.level)
-			g.prevMousePressed = ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft)
-			return nil
-		}
 		g.prevMousePressed = ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft)
 		return nil
 	}
@@ -1432,17 +2151,7 @@ func (g *Game) Update() error {
 		if ebiten.IsKeyPressed(ebiten.KeyEscape) {
 			// Save to leaderboard if userID is set, score > 0, and not defeated
 			if g.userID > 0 && g.score > 0 && g.rank != "Defeated" {
-				err := game.InsertLeaderboard(
-					g.userID,
-					g.score,
-					g.mainQDone,
-					0,
-					float64(g.scorePercent)/100.0, // accuracy
-					boolToFloat(g.bonusAnswered),  // bonus success
-				)
-				if err != nil {
-					log.Printf("failed to save leaderboard: %v", err)
-				}
+				g.saveLeaderboard()
 			}
 			g.state = StateNameEntry
 		}
@@ -1455,6 +2164,12 @@ func (g *Game) Update() error {
 		if !g.nameInputActive {
 			g.nameInputActive = true
 		}
+		// Students in a classroom session join with a room code instead
+		if inpututil.IsKeyJustPressed(ebiten.KeyTab) {
+			g.openClassJoin()
+			g.prevMousePressed = mousePressed
+			return nil
+		}
 		for _, r := range ebiten.InputChars() {
 			if r == '\n' || r == '\r' {
 				continue
@@ -1471,14 +2186,7 @@ func (g *Game) Update() error {
 			}
 		}
 		if (ebiten.IsKeyPressed(ebiten.KeyEnter) || ebiten.IsKeyPressed(ebiten.KeyKPEnter)) && g.enteredName != "" {
-			g.playerName = g.enteredName
-			userID, err := game.InsertUser(g.playerName)
-			if err != nil {
-				log.Printf("failed to save user: %v", err)
-			} else {
-				g.userID = userID
-			}
-			g.state = StateMenu
+			g.signIn(g.enteredName)
 			g.prevMousePressed = ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft)
 			return nil
 		}
@@ -1492,14 +2200,7 @@ func (g *Game) Update() error {
 		btnY := y + 110 + 60
 		mx, my := ebiten.CursorPosition()
 		if mx >= btnX && mx < btnX+btnW && my >= btnY && my < btnY+btnH && mouseJustPressed && g.enteredName != "" {
-			g.playerName = g.enteredName
-			userID, err := game.InsertUser(g.playerName)
-			if err != nil {
-				log.Printf("failed to save user: %v", err)
-			} else {
-				g.userID = userID
-			}
-			g.state = StateMenu
+			g.signIn(g.enteredName)
 			g.prevMousePressed = ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft)
 			return nil
 		}
@@ -1522,6 +2223,34 @@ func (g *Game) Update() error {
 				g.starModalResult = 2
 			}
 		} else {
+			// Campaign battles always return to the sea chart
+			if g.mode == game.ModeCampaign {
+				g.finishCampaignBattle()
+				if g.starModalResult == 2 {
+					os.Exit(0)
+				}
+				g.starModalResult = 0
+				return nil
+			}
+			// The daily challenge has a single attempt and returns to its screen
+			if g.mode == game.ModeDaily {
+				if g.starModalResult == 2 {
+					os.Exit(0)
+				}
+				g.showStarModal = false
+				g.starModalResult = 0
+				g.openDaily()
+				return nil
+			}
+			// Boss battles unlock the next tier or go back to the subjects
+			if g.mode == game.ModeBoss {
+				if g.starModalResult == 2 {
+					os.Exit(0)
+				}
+				g.finishBossBattle()
+				g.starModalResult = 0
+				return nil
+			}
 			if g.starModalResult == 1 {
 				if g.starCount < 1 {
 					// Retry: reset all state and restart the same subject/difficulty
@@ -1545,23 +2274,9 @@ func (g *Game) Update() error {
 							break
 						}
 					}
-					if allAnswered {
-						// Unlock next difficulty if not already unlocked
-						difficulties := []string{"Easy", "Medium", "Hard", "Extreme"}
-						curIdx := 0
-						for i, d := range difficulties {
-							if d == g.selectedDifficulty {
-								curIdx = i
-								break
-							}
-						}
-						if curIdx+1 < len(difficulties) {
-							nextDiff := difficulties[curIdx+1]
-							g.unlockedDifficulties[nextDiff] = true
-						}
-						// Reset answeredSubjects for next difficulty
-						g.answeredSubjects = make(map[string]bool)
-						g.state = StateSelectDifficulty
+					if allAnswered && !g.progress.Cleared(g.selectedDifficulty) {
+						// The tier's boss stands between the player and the next difficulty
+						g.startBossBattle(g.selectedDifficulty)
 						g.starModalResult = 0
 						return nil
 					}
@@ -1594,6 +2309,11 @@ func (g *Game) Update() error {
 //
 // This separation keeps UI and game logic clean, maintainable, and testable.
 func (g *Game) startCombatWithSubjectAndDifficulty(subject, difficulty string) {
+	g.startCombat([]string{subject}, difficulty)
+}
+
+// startCombat sets up a new combat session with questions drawn from a mix of subjects.
+func (g *Game) startCombat(subjects []string, difficulty string) {
 	// --- UI should NOT handle combat state logic directly. ---
 	// All combat state initialization is now handled by game.InitCombatState.
 	// This keeps UI and game logic cleanly separated for maintainability.
@@ -1610,62 +2330,380 @@ func (g *Game) startCombatWithSubjectAndDifficulty(subject, difficulty string) {
 	g.bonusQDone = combatState.BonusQDone
 	g.mainQDone = combatState.MainQDone
 	g.combatOver = combatState.CombatOver
+	g.questionDuration = game.QuestionTimeLimit
 	g.rank = ""
 	g.scorePercent = 0
-
-	// Start timer for first question
-	g.timerActive = true
-	g.questionTimer = time.Now()
-	// Filter questions by subject and difficulty
-	var filtered []game.Question
-	for _, q := range g.quiz.Questions {
-		if q.Subject == subject && q.Difficulty == difficulty {
-			filtered = append(filtered, q)
-		}
-	}
-	// Shuffle questions
-	rand.Seed(time.Now().UnixNano())
-	rand.Shuffle(len(filtered), func(i, j int) { filtered[i], filtered[j] = filtered[j], filtered[i] })
-	// Pick enough questions for this level
+	g.lastPoints = 0
+	g.lastSpeedBonus = 0
+	g.lastResponseTime = 0
+	g.speedBonusTotal = 0
+	g.answerRecords = nil
+	g.streak = 0
+	g.longestStreak = 0
+	g.comboBonusTotal = 0
+	g.comboFlashText = ""
+
+	// Show the enemy intro card; the timer for the first question starts when it closes
+	g.showEnemyIntro = true
+	g.enemyIntroTime = time.Now()
+	g.timerActive = false
+	g.lastEnemyAction = ""
+	g.powerUps.StartBattle()
+	g.powerUpMsg = ""
+	g.secondChance = false
+	g.resetQuestionAids()
+	// Pick enough shuffled questions for this level: one bonus plus the main questions
 	mainCount := game.GetMainQuestionsCount(combatState.Level)
-	if len(filtered) < mainCount+1 {
-		mainCount = len(filtered) - 1
-	}
-	g.quizQuestions = make([]QuizQuestion, 0, mainCount+1)
+	g.setBattleQuestions(g.quiz.SelectQuestions(subjects, difficulty, mainCount+1))
+	g.currentQ = 0
+	g.selectedAns = -1
+	g.showFeedback = false
+	g.answerRects = nil
+	g.showStarModal = false
+}
+
+// setBattleQuestions loads the questions of a battle: the first is the bonus question,
+// the rest are main questions.
+func (g *Game) setBattleQuestions(filtered []game.Question) {
+	g.quizQuestions = make([]QuizQuestion, 0, len(filtered))
 	if len(filtered) > 0 {
 		// First is bonus - initialize with no animation/damage flags
 		g.quizQuestions = append(g.quizQuestions, QuizQuestion{
 			Question: "[BONUS] " + filtered[0].Text,
 			Options:  filtered[0].Choices,
-			Answer:   indexOf(filtered[0].Answer, filtered[0].Choices),
+			Answer:   filtered[0].AnswerIndex(),
+			Hint:     filtered[0].Hint,
+			Subject:  filtered[0].Subject,
 		})
 		// Ensure no animations or damage for bonus question
 		g.pendingAnswer = false
 		// The rest are main questions
-		for i := 1; i <= mainCount && i < len(filtered); i++ {
+		for i := 1; i < len(filtered); i++ {
 			g.quizQuestions = append(g.quizQuestions, QuizQuestion{
 				Question: filtered[i].Text,
 				Options:  filtered[i].Choices,
-				Answer:   indexOf(filtered[i].Answer, filtered[i].Choices),
+				Answer:   filtered[i].AnswerIndex(),
+				Hint:     filtered[i].Hint,
+				Subject:  filtered[i].Subject,
 			})
 		}
 	}
 	g.bonusQIndex = 0
-	g.currentQ = 0
-	g.selectedAns = -1
-	g.showFeedback = false
-	g.answerRects = nil
-	g.showStarModal = false
 }
 
-// indexOf returns the index of ans in choices, or 0 if not found
-func indexOf(ans string, choices []string) int {
-	for i, c := range choices {
-		if c == ans {
+// processAnswer runs the combat rules for the current question and applies the result.
+func (g *Game) processAnswer(isCorrect, isTimeout bool, responseTime time.Duration) {
+	timeLimit := g.questionDuration + g.extraTime
+	var cs game.CombatState
+	if g.mode == game.ModeVersus {
+		// The combo belongs to whoever is answering
+		g.streak = g.versus.Players[g.versus.Turn].Streak
+		cs, g.versusShieldsLost, g.versusDamage = g.versus.Answer(isCorrect, responseTime, timeLimit, g.hintRevealed)
+	} else if g.mode == game.ModeFleet {
+		g.streak = g.fleet.Ships[g.fleet.Turn].Streak
+		before := g.fleet.EnemyHP
+		cs = g.fleet.Answer(isCorrect, isTimeout, responseTime, timeLimit, g.hintRevealed)
+		g.fleetHit = before - cs.EnemyHP
+	} else if g.mode == game.ModeBoss {
+		cs = game.ProcessBossAnswer(
+			g.boss, g.level, g.playerHP, g.playerShields, g.enemyHP, g.score, g.streak, g.bossTurn,
+			isCorrect, responseTime, timeLimit, g.hintRevealed,
+		)
+		g.bossTurn++
+		cs.MainQDone += g.mainQDone
+	} else {
+		isBonusQ := g.currentQ == g.bonusQIndex // First question is bonus
+		questionsLeft := len(g.quizQuestions) - g.currentQ - 1
+		cs = game.ProcessAnswer(
+			g.level, g.playerHP, g.playerShields, g.enemyHP, g.enemyMaxHP, g.score, g.mainQDone, g.bonusActive, g.bonusAnswered,
+			g.bonusQIndex, g.currentQ, isBonusQ, isCorrect, questionsLeft, responseTime, timeLimit, g.streak, isTimeout, g.hintRevealed,
+		)
+	}
+	g.recordAnswer(cs, isCorrect, isTimeout, responseTime)
+
+	// Update game state
+	g.playerHP = cs.PlayerHP
+	g.playerShields = cs.PlayerShields
+	g.enemyHP = cs.EnemyHP
+	g.score = cs.Score
+	g.mainQDone = cs.MainQDone
+	g.bonusActive = cs.BonusActive
+	g.bonusAnswered = cs.BonusAnswered
+	g.combatOver = cs.CombatOver
+	if g.mode == game.ModeBoss {
+		g.bossPhase = cs.Phase
+		g.questionDuration = g.boss.Phases[cs.Phase].TimeLimit
+	}
+	if g.mode == game.ModeSurvival && g.enemyHP == 0 && g.playerHP > 0 {
+		g.nextSurvivalWave()
+	}
+	switch g.mode {
+	case game.ModeVersus:
+		g.syncVersus()
+	case game.ModeFleet:
+		g.syncFleet()
+	}
+}
+
+// advanceQuestion moves on to the next question and starts its timer.
+func (g *Game) advanceQuestion() {
+	g.currentQ++
+	g.resetQuestionAids()
+	if g.mode == game.ModeSurvival {
+		g.refillSurvivalQuestions()
+	}
+	if g.mode == game.ModeFleet && !g.combatOver {
+		g.pushFleetQuestion()
+	}
+	// Hand over the keyboard with a turn card before the next question
+	if (g.mode == game.ModeVersus || g.mode == game.ModeFleet) && g.currentQ < len(g.quizQuestions) && !g.combatOver {
+		g.showEnemyIntro = true
+		g.enemyIntroTime = time.Now()
+		g.timerActive = false
+		return
+	}
+	if g.currentQ < len(g.quizQuestions) {
+		g.timerActive = true
+		g.questionTimer = time.Now()
+	}
+}
+
+// calcRank sets the rank and score percent of the finished battle. Speed and combo bonuses
+// don't count towards the rank, and a boss that is still afloat means defeat.
+func (g *Game) calcRank() {
+	g.rank, g.scorePercent = game.CalcRankAndPercent(g.score-g.speedBonusTotal-g.comboBonusTotal, g.mainQDone, g.bonusAnswered, g.enemyHP, g.playerHP, g.level)
+	if g.mode == game.ModeBoss && g.enemyHP > 0 {
+		g.rank, g.scorePercent = "Defeated", 0
+	}
+}
+
+// recordAnswer keeps the speed breakdown of the last answer for feedback and results.
+func (g *Game) recordAnswer(cs game.CombatState, correct, timedOut bool, responseTime time.Duration) {
+	g.lastPoints = cs.PointsEarned
+	g.lastSpeedBonus = cs.SpeedBonus
+	g.lastResponseTime = responseTime
+	g.speedBonusTotal += cs.SpeedBonus
+	g.comboBonusTotal += cs.ComboBonus
+	g.updateCombo(cs.Streak, cs.Multiplier)
+	switch g.mode {
+	case game.ModeVersus:
+		g.lastEnemyAction = g.versusActionText()
+	case game.ModeFleet:
+		g.lastEnemyAction = g.fleetActionText(cs)
+	case game.ModeBoss:
+		g.lastEnemyAction = g.bossActionText(cs)
+	default:
+		g.lastEnemyAction = enemyActionText(game.GetEnemyClass(g.level), cs)
+	}
+	q := g.quizQuestions[g.currentQ]
+	chosen := ""
+	if !timedOut && g.selectedAns >= 0 && g.selectedAns < len(q.Options) {
+		chosen = q.Options[g.selectedAns]
+	}
+	text := strings.TrimPrefix(q.Question, "[BONUS] ")
+	g.answerRecords = append(g.answerRecords, game.AnswerRecord{
+		QuestionID:   game.QuestionID(q.Subject, text),
+		Question:     text,
+		Chosen:       chosen,
+		Correct:      correct,
+		TimedOut:     timedOut,
+		ResponseTime: responseTime,
+		SpeedBonus:   cs.SpeedBonus,
+		PowerUps:     append([]game.PowerUp(nil), g.questionPowerUps...),
+		HintUsed:     g.hintRevealed,
+		Subject:      q.Subject,
+		Difficulty:   levelNames[g.level],
+	})
+	if correct && cs.Streak > 0 && g.mode != game.ModeVersus && g.mode != game.ModeFleet {
+		if kind, ok := g.powerUps.EarnForStreak(cs.Streak); ok {
+			g.flashPowerUp("Streak reward: +1 " + kind.String())
+		}
+	}
+}
+
+// saveAttempts logs the answers of the finished battle for analytics.
+func (g *Game) saveAttempts() {
+	if g.userID <= 0 {
+		return
+	}
+	b := game.Battle{UserID: g.userID, Mode: g.mode, Subject: g.selectedSubject, Difficulty: g.selectedDifficulty}
+	if _, err := g.store.InsertAttempts(b, g.answerRecords); err != nil {
+		log.Printf("failed to save attempts: %v", err)
+	}
+}
+
+// resetQuestionAids clears the per-question effects of power-ups.
+func (g *Game) resetQuestionAids() {
+	g.removedChoices = make(map[int]bool)
+	g.extraTime = 0
+	g.questionPowerUps = nil
+	g.hintRevealed = false
+	g.hintRect = image.Rectangle{}
+	g.captainPlanned = false
+}
+
+// flashPowerUp shows a short power-up message above the power-up bar.
+func (g *Game) flashPowerUp(msg string) {
+	g.powerUpMsg = msg
+	g.powerUpMsgTime = time.Now()
+}
+
+// usePowerUp applies a power-up to the current question, paying with a charge or score.
+func (g *Game) usePowerUp(kind game.PowerUp) {
+	q := g.quizQuestions[g.currentQ]
+	removeIdx := -1
+	switch kind {
+	case game.PowerFiftyFifty:
+		removeIdx = game.FiftyFifty(len(q.Options), q.Answer, g.removedChoices)
+		if removeIdx < 0 {
+			g.flashPowerUp("No wrong choice left to remove")
+			return
+		}
+	case game.PowerRepair:
+		if g.playerShields >= g.playerMaxShields {
+			g.flashPowerUp("Shields are already full")
+			return
+		}
+	case game.PowerSecondChance:
+		if g.secondChance {
+			return
+		}
+	}
+	cost, ok := g.powerUps.Use(kind, g.level, g.score)
+	if !ok {
+		g.flashPowerUp(kind.String() + " is not available")
+		return
+	}
+	g.score -= cost
+	switch kind {
+	case game.PowerFiftyFifty:
+		g.removedChoices[removeIdx] = true
+	case game.PowerExtraTime:
+		g.extraTime += game.ExtraTimeBonus
+	case game.PowerRepair:
+		g.playerShields = game.RepairShield(g.playerShields, g.playerMaxShields)
+	case game.PowerSecondChance:
+		g.secondChance = true
+	}
+	// A second chance is logged with the question it forgives, once spent
+	if kind != game.PowerSecondChance {
+		g.questionPowerUps = append(g.questionPowerUps, kind)
+	}
+	msg := kind.String() + " used"
+	if cost > 0 {
+		msg += " (-" + itoa(cost) + " pts)"
+	}
+	g.flashPowerUp(msg)
+}
+
+// drawPowerUps draws the power-up buttons between the ships.
+func (g *Game) drawPowerUps(screen *ebiten.Image) {
+	g.powerUpRects = g.powerUpRects[:0]
+	// Power-ups would favour whoever owns the inventory, so shared-screen modes go without
+	if g.showFeedback || g.combatOver || g.currentQ >= len(g.quizQuestions) || g.mode == game.ModeVersus || g.mode == game.ModeFleet {
+		return
+	}
+	labels := []string{"50/50", "+Time", "Repair", "Retry"}
+	btnW, btnH, gap := 100, 56, 12
+	totalW := len(labels)*btnW + (len(labels)-1)*gap
+	startX := (ScreenWidth - totalW) / 2
+	btnY := ScreenHeight - 110
+	for i, label := range labels {
+		kind := game.PowerUp(i)
+		btnX := startX + i*(btnW+gap)
+		usable := g.powerUps.CanUse(kind, g.level, g.score)
+		bgCol := OceanTeal
+		textCol := SmokeWhite
+		if !usable {
+			bgCol = GunmetalGray
+			textCol = color.RGBA{150, 150, 150, 255}
+		}
+		if kind == game.PowerSecondChance && g.secondChance {
+			bgCol = VictoryGold
+			textCol = NavyBlue
+		}
+		vector.DrawFilledRect(screen, float32(btnX), float32(btnY), float32(btnW), float32(btnH), bgCol, true)
+		vector.StrokeRect(screen, float32(btnX), float32(btnY), float32(btnW), float32(btnH), 2, VictoryGold, true)
+		// Show owned charges, or the price in score if none are left
+		sub := "x" + itoa(g.powerUps.Charges[kind])
+		if g.powerUps.Charges[kind] == 0 {
+			sub = itoa(game.PowerUpCost(kind, g.level)) + "pt"
+		}
+		if g.powerUps.Used[kind] > 0 {
+			sub = "used"
+		}
+		text.Draw(screen, label, g.confirmFont, btnX+8, btnY+22, textCol)
+		text.Draw(screen, itoa(i+1)+" "+sub, g.confirmFont, btnX+8, btnY+46, textCol)
+		g.powerUpRects = append(g.powerUpRects, image.Rect(btnX, btnY, btnX+btnW, btnY+btnH))
+	}
+	if g.powerUpMsg != "" && time.Since(g.powerUpMsgTime) < 2*time.Second {
+		bounds, _ := font.BoundString(g.confirmFont, g.powerUpMsg)
+		msgW := (bounds.Max.X - bounds.Min.X).Ceil()
+		drawWrappedTextWithShadow(screen, g.powerUpMsg, g.confirmFont, (ScreenWidth-msgW)/2, btnY-14, msgW+8, 20, VictoryGold)
+	}
+}
+
+// enemyActionText describes a special enemy attack or repair, or "" if there was none.
+func enemyActionText(class game.EnemyClass, cs game.CombatState) string {
+	var parts []string
+	if cs.EnemyShots > 1 {
+		parts = append(parts, class.Name+" fired "+itoa(cs.EnemyShots)+" times!")
+	} else if cs.EnemyShots == 1 && class.ShieldDrain > 1 {
+		parts = append(parts, "Heavy shell! -"+itoa(class.ShieldDrain)+" shields")
+	}
+	if cs.EnemyRepair > 0 {
+		parts = append(parts, class.Name+" repaired "+itoa(cs.EnemyRepair)+" HP")
+	}
+	return strings.Join(parts, " ")
+}
+
+// updateCombo tracks the streak and queues a HUD announcement when the combo grows or breaks.
+func (g *Game) updateCombo(streak int, multiplier float64) {
+	if streak > g.streak && streak >= 2 {
+		g.comboFlashText = "COMBO x" + formatMultiplier(multiplier) + "!"
+		g.comboFlashCol = VictoryGold
+		g.comboFlashTime = time.Now()
+	} else if streak == 0 && g.streak >= 2 {
+		g.comboFlashText = "Combo broken!"
+		g.comboFlashCol = AlertRed
+		g.comboFlashTime = time.Now()
+	}
+	g.streak = streak
+	if streak > g.longestStreak {
+		g.longestStreak = streak
+	}
+}
+
+// formatMultiplier formats a combo multiplier with up to two decimals, e.g. "1.25"
+func formatMultiplier(m float64) string {
+	hundredths := int(math.Round(m * 100))
+	s := itoa(hundredths / 100)
+	if frac := hundredths % 100; frac != 0 {
+		if frac%10 == 0 {
+			s += "." + itoa(frac/10)
+		} else if frac < 10 {
+			s += ".0" + itoa(frac)
+		} else {
+			s += "." + itoa(frac)
+		}
+	}
+	return s
+}
+
+// formatSeconds formats a duration as seconds with one decimal, e.g. "3.2s"
+func formatSeconds(d time.Duration) string {
+	tenths := int(d.Milliseconds() / 100)
+	return itoa(tenths/10) + "." + itoa(tenths%10) + "s"
+}
+
+// hitRect returns the index of the rectangle containing (x, y), or -1
+func hitRect(rects []image.Rectangle, x, y int) int {
+	for i, rect := range rects {
+		if x >= rect.Min.X && x < rect.Max.X && y >= rect.Min.Y && y < rect.Max.Y {
 			return i
 		}
 	}
-	return 0
+	return -1
 }
 
 func (g *Game) drawNameEntry(screen *ebiten.Image) {
@@ -1747,6 +2785,7 @@ func (g *Game) drawNameEntry(screen *ebiten.Image) {
 	cfHeight := cfAscent + cfDescent
 	cfY := btnY + (btnH+cfHeight)/2 - cfDescent
 	drawWrappedTextWithShadow(screen, btnText, cf, btnX+(btnW-width)/2, cfY, btnW-24, 24, SmokeWhite)
+	drawWrappedTextWithShadow(screen, "In class? Press TAB to join your teacher's session.", cf, x, y+h+40, w, 24, SmokeWhite)
 }
 
 func (g *Game) initLogo() {
@@ -1835,31 +2874,95 @@ func (g *Game) initShips() {
 			}
 		}
 	}
+
+	// Load a sprite sheet for each enemy class
+	for level := range g.enemyClassFrames {
+		g.enemyClassFrames[level] = loadShipFrames(game.GetEnemyClass(level).Sprite)
+	}
+}
+
+// loadShipFrames loads a 4-frame (64x64 each) ship sprite sheet; frames are nil if it can't be loaded.
+func loadShipFrames(path string) [4]*ebiten.Image {
+	var frames [4]*ebiten.Image
+	data, err := os.ReadFile(path)
+	if err != nil {
+		log.Printf("failed to load ship sprite %s: %v", path, err)
+		return frames
+	}
+	img, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		log.Printf("failed to decode ship sprite %s: %v", path, err)
+		return frames
+	}
+	sheet := ebiten.NewImageFromImage(img)
+	if b := sheet.Bounds(); b.Dx() < 256 || b.Dy() < 64 {
+		log.Printf("ship sprite %s is too small: got %dx%d, need at least 256x64", path, b.Dx(), b.Dy())
+		return frames
+	}
+	for i := 0; i < 4; i++ {
+		frames[i] = sheet.SubImage(image.Rect(i*64, 0, (i+1)*64, 64)).(*ebiten.Image)
+	}
+	return frames
+}
+
+// drawEnemyIntro draws the card introducing the enemy class at the start of a battle.
+func (g *Game) drawEnemyIntro(screen *ebiten.Image) {
+	class := game.GetEnemyClass(g.level)
+	title, intro := "Enemy sighted: "+class.Name, class.Intro
+	switch g.mode {
+	case game.ModeBoss:
+		title, intro = "BOSS: "+g.boss.Name, g.boss.Intro
+	case game.ModeVersus:
+		p := g.versus.Players[g.versus.Turn]
+		title = p.Name + "'s turn"
+		intro = "Pass the controls to " + p.Name + ". Answer right to fire on the other ship: shields go first, then the hull."
+	case game.ModeFleet:
+		s := g.fleet.Ships[g.fleet.Turn]
+		title = s.Name + "'s turn"
+		intro = "A " + s.Subject + " question for " + s.Name + ". Every hit adds to the fleet's damage; a miss only hurts your own ship."
+	}
+	if g.computerTurn() {
+		intro = g.turnSeat().SeatName() + " is a computer captain and answers on its own. Watch closely!"
+	}
+	w, h := 640, 240
+	x := (ScreenWidth - w) / 2
+	y := (ScreenHeight - h) / 2
+	for r := 0; r < 3; r++ {
+		alpha := uint8(60 - r*20)
+		vector.StrokeRect(screen, float32(x-r*3), float32(y-r*3), float32(w+2*r*3), float32(h+2*r*3), 6, color.RGBA{AlertRed.R, AlertRed.G, AlertRed.B, alpha}, true)
+	}
+	vector.DrawFilledRect(screen, float32(x), float32(y), float32(w), float32(h), GunmetalGray, true)
+	vector.StrokeRect(screen, float32(x), float32(y), float32(w), float32(h), 4, VictoryGold, true)
+	drawWrappedTextWithShadow(screen, title, g.gameFont, x+32, y+56, w-64, 36, AlertRed)
+	drawWrappedTextWithShadow(screen, intro, g.confirmFont, x+32, y+110, w-64, 26, SmokeWhite)
+	drawWrappedTextWithShadow(screen, "(Click or press SPACE to engage)", g.confirmFont, x+32, y+h-32, w-64, 24, OceanTeal)
+}
+
+// playerFrameIndex picks the damage frame of a player ship: 0 is intact, 3 is sunk.
+func playerFrameIndex(hp, maxHP int) int {
+	if hp <= 0 || maxHP <= 0 {
+		return 3
+	}
+	percent := float64(hp) / float64(maxHP)
+	switch {
+	case percent >= 0.76:
+		return 0 // 100–76%
+	case percent >= 0.51:
+		return 1 // 75–51%
+	default:
+		return 2 // 50–1%
+	}
 }
 
 func (g *Game) drawShips(screen *ebiten.Image) {
 	// Calculate ship positions
 	shipY := ScreenHeight - 60 // Position ships closer to the bottom
 
-	// Player ship on the left (frame by frame, safe nil check)
-	playerFrameIdx := 3
-	if g.playerHP > 0 && g.playerMaxHP > 0 {
-		percent := float64(g.playerHP) / float64(g.playerMaxHP)
-		switch {
-		case percent >= 0.76:
-			playerFrameIdx = 0 // 100–76%
-		case percent >= 0.51:
-			playerFrameIdx = 1 // 75–51%
-		case percent > 0:
-			playerFrameIdx = 2 // 50–1%
-		default:
-			playerFrameIdx = 3 // 0%
-		}
-	} else {
-		playerFrameIdx = 3
-	}
-	playerFrame := g.playerShipFrames[playerFrameIdx]
-	if playerFrame != nil {
+	// Player ship on the left (frame by frame, safe nil check); a fleet draws a row of smaller ships
+	playerFrame := g.playerShipFrames[playerFrameIndex(g.playerHP, g.playerMaxHP)]
+	if g.mode == game.ModeFleet && g.fleet != nil {
+		g.drawFleetShips(screen)
+	} else if playerFrame != nil {
 		playerShipW := 200
 		playerShipH := 140
 		playerShipX := 60
@@ -1890,7 +2993,13 @@ func (g *Game) drawShips(screen *ebiten.Image) {
 	} else {
 		frameIdx = 0
 	}
-	enemyFrame := g.enemyShipFrames[frameIdx]
+	enemyFrame := g.enemyClassFrames[g.level][frameIdx]
+	if g.mode == game.ModeBoss && g.bossFrames[frameIdx] != nil {
+		enemyFrame = g.bossFrames[frameIdx]
+	}
+	if enemyFrame == nil {
+		enemyFrame = g.enemyShipFrames[frameIdx]
+	}
 	if enemyFrame != nil {
 		enemyShipW := 200
 		enemyShipH := 140
@@ -1905,8 +3014,12 @@ func (g *Game) drawShips(screen *ebiten.Image) {
 		screen.DrawImage(enemyFrame, op)
 	}
 
+	if g.mode == game.ModeVersus && g.versus != nil {
+		g.drawVersusShipLabels(screen, shipY-140)
+	}
+
 	// Draw fire overlay if active
-	if g.showFire && g.fireType != 0 {
+	if g.showFire && g.fireType != 0 && g.mode != game.ModeFleet {
 		if g.fireType == 1 && g.fireImgPlayer != nil {
 			// Player fire: right edge of player ship to left edge of enemy ship
 			playerShipW := 200
@@ -1956,7 +3069,7 @@ func boolToFloat(b bool) float64 {
 }
 
 func (g *Game) drawStarModal(screen *ebiten.Image) {
-	w, h := 700, 380 // Modal size unchanged
+	w, h := 700, 480
 	x := (ScreenWidth - w) / 2
 	y := (ScreenHeight - h) / 2
 	// Border color: red if failed, gold otherwise
@@ -1969,6 +3082,9 @@ func (g *Game) drawStarModal(screen *ebiten.Image) {
 	vector.StrokeRect(screen, float32(x), float32(y), float32(w), float32(h), 2, borderColor, true)
 
 	title := "Test Results"
+	if g.mode == game.ModeBoss {
+		title = "Boss Battle: " + g.boss.Name
+	}
 	drawWrappedTextWithShadow(screen, title, g.confirmFont, x+32, y+48, w-64, 28, VictoryGold)
 
 	// --- Draw star strip ---
@@ -2011,7 +3127,7 @@ func (g *Game) drawStarModal(screen *ebiten.Image) {
 	// Get total score from all subjects
 	totalScore := 0
 	if g.userID > 0 {
-		if total, err := game.GetTotalScore(g.userID); err == nil {
+		if total, err := g.store.GetTotalScore(g.userID); err == nil {
 			totalScore = total
 		}
 	}
@@ -2025,8 +3141,32 @@ func (g *Game) drawStarModal(screen *ebiten.Image) {
 	totalScoreY := scoreY + 32 // More space between score and total
 	drawWrappedTextWithShadow(screen, totalScoreText, g.confirmFont, x+32, totalScoreY, w-64, 24, OceanTeal)
 
+	// Show how much of the score came from answering quickly
+	speedText := "Speed bonus: +" + itoa(g.speedBonusTotal) + " pts (avg " + formatSeconds(game.AverageResponseTime(g.answerRecords)) + ")"
+	speedY := totalScoreY + 32
+	drawWrappedTextWithShadow(screen, speedText, g.confirmFont, x+32, speedY, w-64, 24, SmokeWhite)
+
+	// Show the best combo of this battle
+	streakText := "Best streak: " + itoa(g.longestStreak) + " (combo +" + itoa(g.comboBonusTotal) + " pts)"
+	streakY := speedY + 28
+	drawWrappedTextWithShadow(screen, streakText, g.confirmFont, x+32, streakY, w-64, 24, SmokeWhite)
+
+	// Show reliance on aids
+	aidsText := "Power-ups used: " + itoa(g.powerUps.UsedCount())
+	var aidNames []string
+	for k := game.PowerUp(0); k < game.NumPowerUps; k++ {
+		if g.powerUps.Used[k] > 0 {
+			aidNames = append(aidNames, k.String())
+		}
+	}
+	if len(aidNames) > 0 {
+		aidsText += " (" + strings.Join(aidNames, ", ") + ")"
+	}
+	aidsY := streakY + 28
+	drawWrappedTextWithShadow(screen, aidsText, g.confirmFont, x+32, aidsY, w-64, 24, SmokeWhite)
+
 	rank := g.getRankText()
-	rankY := totalScoreY + 36 // More space between total and rank
+	rankY := aidsY + 36 // More space between aids and rank
 	drawWrappedTextWithShadow(screen, rank, g.confirmFont, x+32, rankY, w-64, 24, g.getRankColor())
 
 	// Feedback
@@ -2050,7 +3190,11 @@ func (g *Game) drawStarModal(screen *ebiten.Image) {
 	vector.StrokeRect(screen, float32(btnX1), float32(btnY), float32(btnW), float32(btnH), 2, VictoryGold, true)
 	vector.DrawFilledRect(screen, float32(btnX2), float32(btnY), float32(btnW), float32(btnH), AlertRed, true)
 	vector.StrokeRect(screen, float32(btnX2), float32(btnY), float32(btnW), float32(btnH), 2, VictoryGold, true)
-	if g.starCount < 1 {
+	if g.mode == game.ModeCampaign {
+		drawWrappedTextWithShadow(screen, "Chart", g.confirmFont, btnX1+32, btnY+30, btnW-36, 20, SmokeWhite)
+	} else if g.mode == game.ModeDaily || (g.mode == game.ModeBoss && g.enemyHP > 0) {
+		drawWrappedTextWithShadow(screen, "Back", g.confirmFont, btnX1+32, btnY+30, btnW-36, 20, SmokeWhite)
+	} else if g.starCount < 1 {
 		drawWrappedTextWithShadow(screen, "Retry", g.confirmFont, btnX1+32, btnY+30, btnW-36, 20, SmokeWhite)
 	} else {
 		drawWrappedTextWithShadow(screen, "Continue", g.confirmFont, btnX1+32, btnY+30, btnW-36, 20, SmokeWhite)
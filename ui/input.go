@@ -0,0 +1,140 @@
+package ui
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+// Buzzer keys of the players sharing the keyboard, spread out so four people fit around it
+var buzzerKeys = []ebiten.Key{ebiten.KeyQ, ebiten.KeyP, ebiten.KeyZ, ebiten.KeyM}
+
+// Gamepad face buttons answer choices 1-4
+var padChoiceButtons = []ebiten.StandardGamepadButton{
+	ebiten.StandardGamepadButtonRightBottom,
+	ebiten.StandardGamepadButtonRightRight,
+	ebiten.StandardGamepadButtonRightLeft,
+	ebiten.StandardGamepadButtonRightTop,
+}
+
+// playerInput is the device of one player: their buzzer key, and a gamepad once one joins
+type playerInput struct {
+	key    ebiten.Key
+	pad    ebiten.GamepadID
+	hasPad bool
+	// captain is the computer personality playing the seat, -1 for a person.
+	// Computer seats take no keys or gamepads.
+	captain int
+}
+
+// label names the controls of the player for the lobby and the question screen
+func (in playerInput) label() string {
+	if in.captain >= 0 {
+		return "Computer"
+	}
+	if in.hasPad {
+		return "Gamepad " + itoa(int(in.pad)+1)
+	}
+	return in.key.String() + " key"
+}
+
+// buzzed reports whether the player hit their buzzer this tick. Any face or
+// shoulder button of their gamepad counts as a buzz.
+func (in playerInput) buzzed() bool {
+	if in.captain >= 0 {
+		return false
+	}
+	if inpututil.IsKeyJustPressed(in.key) {
+		return true
+	}
+	if !in.hasPad {
+		return false
+	}
+	for _, b := range inpututil.AppendJustPressedStandardGamepadButtons(in.pad, nil) {
+		if b <= ebiten.StandardGamepadButtonFrontBottomRight {
+			return true
+		}
+	}
+	return false
+}
+
+// choice returns the choice the player picked on their gamepad, or -1
+func (in playerInput) choice(n int) int {
+	if !in.hasPad || in.captain >= 0 {
+		return -1
+	}
+	for i, b := range padChoiceButtons {
+		if i < n && inpututil.IsStandardGamepadButtonJustPressed(in.pad, b) {
+			return i
+		}
+	}
+	return -1
+}
+
+// partyInput maps keyboard keys and gamepads to party players
+type partyInput struct {
+	players []playerInput
+}
+
+func newPartyInput(n int) *partyInput {
+	in := &partyInput{}
+	in.resize(n)
+	return in
+}
+
+// resize sets the number of players, keeping the devices of those who stay
+func (in *partyInput) resize(n int) {
+	for len(in.players) < n {
+		in.players = append(in.players, playerInput{key: buzzerKeys[len(in.players)], captain: -1})
+	}
+	in.players = in.players[:n]
+}
+
+// joinGamepads hands a gamepad to the first keyboard player when any of its
+// buttons is pressed, and drops gamepads that were unplugged. It returns the
+// player a gamepad joined as, or -1. Computer seats never get one.
+func (in *partyInput) joinGamepads() int {
+	connected := map[ebiten.GamepadID]bool{}
+	for _, id := range ebiten.AppendGamepadIDs(nil) {
+		connected[id] = true
+	}
+	for i := range in.players {
+		if in.players[i].hasPad && !connected[in.players[i].pad] {
+			in.players[i].hasPad = false
+		}
+	}
+	for id := range connected {
+		if in.owner(id) >= 0 || len(inpututil.AppendJustPressedGamepadButtons(id, nil)) == 0 {
+			continue
+		}
+		for i := range in.players {
+			if !in.players[i].hasPad && in.players[i].captain < 0 {
+				in.players[i].pad, in.players[i].hasPad = id, true
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// owner returns the player using gamepad id, or -1
+func (in *partyInput) owner(id ebiten.GamepadID) int {
+	for i, p := range in.players {
+		if p.hasPad && p.pad == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// buzzer returns the first player who buzzed this tick and may buzz, or -1
+func (in *partyInput) buzzer(lockedOut []bool) int {
+	for i, p := range in.players {
+		if i < len(lockedOut) && lockedOut[i] {
+			continue
+		}
+		if p.buzzed() {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,282 @@
+package ui
+
+import (
+	"image"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/RALPH22222/Broadside/netplay"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// lanAddrRect is the input box of the server address
+var lanAddrRect = image.Rect(212, 300, ScreenWidth-212, 348)
+
+// lanSubjectRect is the subject picker of a LAN fleet, under the address
+var lanSubjectRect = image.Rect(212, 400, ScreenWidth-212, 448)
+
+// openLanConnect asks for the address of the match server, and for a fleet the
+// subject of our ship.
+func (g *Game) openLanConnect(fleet bool) {
+	if g.lanAddr == "" {
+		g.lanAddr = "127.0.0.1" + netplay.DefaultAddr
+	}
+	if len(g.subjects) == 0 {
+		g.subjects = g.quiz.ListSubjects()
+	}
+	g.lanFleet = fleet
+	g.lanMsg = ""
+	g.state = StateLanConnect
+}
+
+func (g *Game) drawLanConnect(screen *ebiten.Image) {
+	title, help := "LAN Duel", "Ask your teacher for the address of the match server (started with `broadside serve`)."
+	if g.lanFleet {
+		title, help = "LAN Fleet", "Ask your teacher for the address of the fleet server (started with `broadside serve -fleet`). Pick the subject your ship answers."
+	}
+	drawWrappedTextWithShadow(screen, title, g.gameFont, 40, 60, ScreenWidth-80, 36, VictoryGold)
+	drawWrappedTextWithShadow(screen, help, g.confirmFont, 40, 100, ScreenWidth-80, 24, SmokeWhite)
+	r := lanAddrRect
+	drawWrappedTextWithShadow(screen, "Server address:", g.gameFont, r.Min.X, r.Min.Y-16, r.Dx(), 36, VictoryGold)
+	vector.DrawFilledRect(screen, float32(r.Min.X), float32(r.Min.Y), float32(r.Dx()), float32(r.Dy()), SmokeWhite, true)
+	vector.StrokeRect(screen, float32(r.Min.X), float32(r.Min.Y), float32(r.Dx()), float32(r.Dy()), 3, OceanTeal, true)
+	addr := g.lanAddr
+	if (time.Now().UnixNano()/500_000_000)%2 == 0 {
+		addr += "|"
+	}
+	drawWrappedTextWithShadow(screen, addr, g.gameFont, r.Min.X+12, r.Min.Y+36, r.Dx()-24, 36, NavyBlue)
+	if g.lanFleet {
+		sr := lanSubjectRect
+		vector.DrawFilledRect(screen, float32(sr.Min.X), float32(sr.Min.Y), float32(sr.Dx()), float32(sr.Dy()), GunmetalGray, true)
+		vector.StrokeRect(screen, float32(sr.Min.X), float32(sr.Min.Y), float32(sr.Dx()), float32(sr.Dy()), 3, VictoryGold, true)
+		drawWrappedTextWithShadow(screen, "Subject (click to change)", g.confirmFont, sr.Min.X, sr.Min.Y-6, sr.Dx(), 24, VictoryGold)
+		drawWrappedTextWithShadow(screen, g.subjects[g.lanSubject], g.gameFont, sr.Min.X+12, sr.Min.Y+36, sr.Dx()-24, 36, SmokeWhite)
+		r.Max.Y = sr.Max.Y
+	}
+	drawWrappedTextWithShadow(screen, "Playing as "+g.lanName(), g.confirmFont, r.Min.X, r.Max.Y+40, r.Dx(), 24, SmokeWhite)
+	drawWrappedTextWithShadow(screen, g.lanMsg, g.confirmFont, r.Min.X, r.Max.Y+80, r.Dx(), 24, AlertRed)
+	drawWrappedTextWithShadow(screen, "(ENTER to connect, ESC to go back)", g.confirmFont, 40, ScreenHeight-32, ScreenWidth-80, 24, SmokeWhite)
+}
+
+// lanName is the name sent to the server
+func (g *Game) lanName() string {
+	if g.playerName != "" {
+		return g.playerName
+	}
+	return "Captain"
+}
+
+func (g *Game) updateLanConnect(mouseJustPressed bool) {
+	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+		g.state = StateMultiplayerSelect
+		return
+	}
+	if x, y := ebiten.CursorPosition(); g.lanFleet && mouseJustPressed && image.Pt(x, y).In(lanSubjectRect) {
+		g.lanSubject = (g.lanSubject + 1) % len(g.subjects)
+	}
+	for _, r := range ebiten.AppendInputChars(nil) {
+		if len(g.lanAddr) < 64 {
+			g.lanAddr += string(r)
+		}
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyBackspace) && len(g.lanAddr) > 0 {
+		_, size := utf8.DecodeLastRuneInString(g.lanAddr)
+		g.lanAddr = g.lanAddr[:len(g.lanAddr)-size]
+	}
+	if !inpututil.IsKeyJustPressed(ebiten.KeyEnter) && !inpututil.IsKeyJustPressed(ebiten.KeyNumpadEnter) {
+		return
+	}
+	addr := strings.TrimSpace(g.lanAddr)
+	if !strings.Contains(addr, ":") {
+		addr += netplay.DefaultAddr
+	}
+	dial := func() (*netplay.Client, error) { return netplay.Dial(addr, g.lanName()) }
+	if g.lanFleet {
+		dial = func() (*netplay.Client, error) { return netplay.DialFleet(addr, g.lanName(), g.subjects[g.lanSubject]) }
+	}
+	c, err := dial()
+	if err != nil {
+		g.lanMsg = "Could not reach the server: " + err.Error()
+		return
+	}
+	g.lanClient = c
+	g.lanPlayers = nil
+	g.lanQuestion = netplay.Message{Round: -1}
+	g.lanResult = nil
+	g.lanOver = nil
+	g.lanMsg = "Connecting..."
+	g.hoveredMenu = -1
+	g.state = StateLanDuel
+}
+
+// closeLan hangs up and returns to the game modes menu.
+func (g *Game) closeLan() {
+	if g.lanClient != nil {
+		g.lanClient.Close()
+		g.lanClient = nil
+	}
+	g.state = StateMultiplayerSelect
+}
+
+func (g *Game) updateLanDuel(mouseJustPressed bool) {
+	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+		g.closeLan()
+		return
+	}
+	for {
+		m, ok := g.lanClient.Poll()
+		if !ok {
+			break
+		}
+		g.handleLanMessage(m)
+	}
+	if g.lanOver != nil || g.lanClient.Err() != nil {
+		if g.lanOver == nil {
+			g.lanMsg = "Connection to the server was lost."
+		}
+		x, y := ebiten.CursorPosition()
+		g.hoveredMenu = hitRect(g.buttonRects, x, y)
+		if mouseJustPressed && g.hoveredMenu == 0 {
+			g.closeLan()
+		}
+		return
+	}
+	// The server times the question; answering just sends the pick once. In a fleet
+	// only the ship whose turn it is answers.
+	if g.lanFleet && g.lanQuestion.Turn != g.lanYou {
+		return
+	}
+	if g.lanQuestion.Round >= 0 && g.lanResult == nil && g.selectedAns < 0 {
+		if choice := g.pickedChoice(mouseJustPressed); choice >= 0 {
+			if err := g.lanClient.Answer(g.lanQuestion.Round, choice); err != nil {
+				g.lanMsg = "Could not send the answer."
+				return
+			}
+			g.selectedAns = choice
+		}
+	}
+}
+
+// handleLanMessage applies a message from the match server.
+func (g *Game) handleLanMessage(m netplay.Message) {
+	if len(m.Players) > 0 {
+		g.lanPlayers = m.Players
+	}
+	if m.FlagshipMax > 0 {
+		g.lanEnemyHP, g.lanEnemyMaxHP = m.Flagship, m.FlagshipMax
+	}
+	if g.lanFleet {
+		g.handleLanFleetMessage(m)
+		return
+	}
+	switch m.Type {
+	case netplay.MsgWaiting:
+		g.lanMsg = "Waiting for an opponent..."
+	case netplay.MsgStart:
+		g.lanYou = m.You
+		g.lanMsg = "Duel against " + m.Name + " (" + m.Difficulty + ")"
+	case netplay.MsgQuestion:
+		g.lanQuestion = m
+		g.lanQuestionTime = time.Now()
+		g.lanResult = nil
+		g.selectedAns = -1
+		g.lanMsg = ""
+	case netplay.MsgResult:
+		g.lanResult = &m
+		g.lanMsg = g.lanResultText(m)
+	case netplay.MsgOver:
+		g.lanOver = &m
+		g.lanMsg = m.Reason
+	case netplay.MsgError:
+		g.lanMsg = m.Error
+	}
+}
+
+func (g *Game) lanResultText(m netplay.Message) string {
+	you, opp := m.Correct[g.lanYou], m.Correct[1-g.lanYou]
+	switch {
+	case you && opp:
+		return "You both hit!"
+	case you:
+		return "Hit! The other ship missed."
+	case opp:
+		return "Missed, and the other ship hit you."
+	}
+	return "You both missed."
+}
+
+func (g *Game) drawLanDuel(screen *ebiten.Image) {
+	if g.lanFleet {
+		g.drawLanFleetHUD(screen)
+	} else if len(g.lanPlayers) == 2 {
+		for side, i := range []int{g.lanYou, 1 - g.lanYou} {
+			p := g.lanPlayers[i]
+			x, col := 40, VictoryGold
+			if side == 1 {
+				x, col = ScreenWidth-340, AlertRed
+			}
+			drawWrappedTextWithShadow(screen, p.Name+": "+itoa(p.Score)+" pts", g.gameFont, x, 40, 300, 36, col)
+			drawWrappedTextWithShadow(screen, "HP "+itoa(p.HP)+"  Shields "+itoa(p.Shields), g.gameFont, x, 80, 300, 36, OceanTeal)
+		}
+	}
+	if g.lanOver != nil {
+		headline := "It's a draw!"
+		if g.lanFleet {
+			headline = "Defeat!"
+			if g.lanEnemyHP == 0 {
+				headline = "Victory!"
+			}
+		} else if w := g.lanOver.Winner; w == g.lanYou {
+			headline = "Victory!"
+		} else if w >= 0 {
+			headline = "Defeat!"
+		}
+		drawWrappedTextWithShadow(screen, headline, g.gameFont, practiceX, 260, practiceW, 36, VictoryGold)
+		drawWrappedTextWithShadow(screen, g.lanMsg, g.gameFont, practiceX, 310, practiceW, 36, SmokeWhite)
+		g.drawButtonRow(screen, []string{"Back"}, nil)
+		return
+	}
+	if g.lanClient.Err() != nil || g.lanQuestion.Round < 0 {
+		drawWrappedTextWithShadow(screen, g.lanMsg, g.gameFont, practiceX, 300, practiceW, 36, SmokeWhite)
+		if g.lanClient.Err() != nil {
+			g.drawButtonRow(screen, []string{"Back"}, nil)
+		}
+		return
+	}
+	q := QuizQuestion{Question: g.lanQuestion.Text, Options: g.lanQuestion.Choices, Answer: -1}
+	if g.lanResult != nil {
+		q.Answer = g.lanResult.Answer
+	}
+	round := "Round " + itoa(g.lanQuestion.Round+1)
+	if g.lanFleet {
+		round = "Turn " + itoa(g.lanQuestion.Round+1)
+	}
+	drawWrappedTextWithShadow(screen, round, g.confirmFont, 40, 140, 300, 24, SmokeWhite)
+	if g.lanResult == nil {
+		left := time.Duration(g.lanQuestion.TimeLimit)*time.Millisecond - time.Since(g.lanQuestionTime)
+		if left < 0 {
+			left = 0
+		}
+		drawWrappedTextWithShadow(screen, "Time: "+itoa(int(left.Seconds())+1)+"s", g.confirmFont, ScreenWidth-240, 140, 200, 24, SmokeWhite)
+	}
+	y := 190
+	drawWrappedTextWithShadow(screen, q.Question, g.gameFont, practiceX, y, practiceW, 36, SmokeWhite)
+	y += len(wrapText(g.gameFont, q.Question, practiceW))*36 + 12
+	y = g.drawChoiceList(screen, q, y, g.lanResult != nil)
+	status := g.lanMsg
+	switch {
+	case g.lanResult != nil:
+	case g.lanFleet && g.lanQuestion.Turn != g.lanYou:
+		status = g.lanShipName(g.lanQuestion.Turn) + " is answering..."
+	case g.lanFleet && g.selectedAns < 0:
+		status = "Your turn!"
+	case g.lanFleet:
+		status = "Answer locked in."
+	case g.selectedAns >= 0:
+		status = "Answer locked in. Waiting for the other ship..."
+	}
+	drawWrappedTextWithShadow(screen, status, g.gameFont, practiceX, y+24, practiceW, 36, VictoryGold)
+}
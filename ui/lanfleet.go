@@ -0,0 +1,70 @@
+package ui
+
+import (
+	"time"
+
+	"github.com/RALPH22222/Broadside/netplay"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// handleLanFleetMessage applies a message from a fleet server.
+func (g *Game) handleLanFleetMessage(m netplay.Message) {
+	switch m.Type {
+	case netplay.MsgWaiting:
+		g.lanMsg = "Waiting for more ships (" + itoa(len(m.Players)) + " aboard)..."
+	case netplay.MsgStart:
+		g.lanYou = m.You
+		g.lanMsg = "A fleet of " + itoa(len(m.Players)) + " sails (" + m.Difficulty + ")"
+	case netplay.MsgQuestion:
+		g.lanQuestion = m
+		g.lanQuestionTime = time.Now()
+		g.lanResult = nil
+		g.selectedAns = -1
+		g.lanMsg = ""
+	case netplay.MsgResult:
+		g.lanResult = &m
+		g.lanMsg = g.lanShipName(m.Turn) + " missed."
+		if m.Correct[m.Turn] {
+			g.lanMsg = g.lanShipName(m.Turn) + " hit the enemy!"
+		}
+	case netplay.MsgOver:
+		g.lanOver = &m
+		g.lanMsg = m.Reason
+	case netplay.MsgError:
+		g.lanMsg = m.Error
+	}
+}
+
+// lanShipName names ship i of the fleet, "You" for our own
+func (g *Game) lanShipName(i int) string {
+	if i == g.lanYou {
+		return "You"
+	}
+	if i < len(g.lanPlayers) {
+		return g.lanPlayers[i].Name
+	}
+	return "Captain " + itoa(i+1)
+}
+
+// drawLanFleetHUD lists the ships of the fleet, whose turn it is, and the enemy.
+func (g *Game) drawLanFleetHUD(screen *ebiten.Image) {
+	asking := g.lanQuestion.Round >= 0 && g.lanResult == nil && g.lanOver == nil
+	for i, s := range g.lanPlayers {
+		col := SmokeWhite
+		switch {
+		case s.HP == 0:
+			col = GunmetalGray
+		case asking && i == g.lanQuestion.Turn:
+			col = VictoryGold
+		}
+		name := s.Name
+		if i == g.lanYou {
+			name += " (you)"
+		}
+		line := name + ": HP " + itoa(s.HP) + " Sh " + itoa(s.Shields) + " - " + itoa(s.Score) + " pts"
+		drawWrappedTextWithShadow(screen, line, g.confirmFont, 40, 24+i*26, 520, 24, col)
+	}
+	if g.lanEnemyMaxHP > 0 {
+		drawWrappedTextWithShadow(screen, "Enemy HP: "+itoa(g.lanEnemyHP)+"/"+itoa(g.lanEnemyMaxHP), g.gameFont, ScreenWidth-380, 40, 360, 36, AlertRed)
+	}
+}
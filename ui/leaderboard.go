@@ -0,0 +1,380 @@
+package ui
+
+import (
+	"image"
+	"image/color"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/RALPH22222/Broadside/game"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+	"golang.org/x/image/font"
+)
+
+// Views of the battle board, picked with the tabs above the overlay
+const (
+	ViewAllTime = iota
+	ViewWeek
+	ViewMonth
+	ViewClass
+	ViewBests
+	numViews
+)
+
+var leaderboardTabs = []string{"All time", "This week", "This month", "Class", "My bests"}
+
+// leaderboardTabRect returns the tab of a view; the subject and difficulty
+// filters follow the tabs as i = numViews and numViews+1.
+func leaderboardTabRect(i int) image.Rectangle {
+	if i >= numViews {
+		y := 530 + (i-numViews)*60
+		return image.Rect(577, y, ScreenWidth-80, y+44)
+	}
+	const tabW, gap = 150, 10
+	x := (ScreenWidth-numViews*tabW-(numViews-1)*gap)/2 + i*(tabW+gap)
+	return image.Rect(x, 24, x+tabW, 68)
+}
+
+// showsLeaderboardTabs reports whether the overlay shows the battle board, the
+// only one with views and filters
+func (g *Game) showsLeaderboardTabs() bool {
+	return g.leaderboardBoard == BoardBattle && !g.leaderboardByRating
+}
+
+// saveLeaderboard adds the finished battle to the leaderboard
+func (g *Game) saveLeaderboard() {
+	err := g.store.InsertLeaderboard(g.userID, game.LeaderboardEntry{
+		Score:           g.score,
+		QuestsCompleted: g.mainQDone,
+		Accuracy:        float64(g.scorePercent) / 100.0,
+		BonusSuccess:    boolToFloat(g.bonusAnswered),
+		LongestStreak:   g.longestStreak,
+		PowerUpsUsed:    g.powerUps.UsedCount(),
+		Mode:            g.mode,
+		Subject:         g.selectedSubject,
+		Difficulty:      g.selectedDifficulty,
+	})
+	if err != nil {
+		log.Printf("failed to save leaderboard: %v", err)
+	}
+}
+
+// leaderboardPageRows is how many rows the battle board shows at a time
+const leaderboardPageRows = 10
+
+// fetchBattleBoard loads the battle board for the selected view and filters from
+// the top, with where the player ranks in it.
+func (g *Game) fetchBattleBoard() {
+	g.leaderboardEntries, g.personalBests, g.leaderboardNote = nil, nil, ""
+	g.leaderboardQuery, g.leaderboardOffset, g.leaderboardTotal, g.leaderboardRank = nil, 0, 0, 0
+	if g.leaderboardView == ViewBests {
+		if g.userID <= 0 {
+			g.leaderboardNote = "Sign in to keep your personal bests."
+			return
+		}
+		bests, err := g.store.GetPersonalBests(g.userID)
+		if err != nil {
+			log.Printf("failed to load personal bests: %v", err)
+		}
+		for _, b := range bests {
+			if (g.leaderboardSubject == "" || b.Subject == g.leaderboardSubject) && (g.leaderboardDifficulty == "" || b.Difficulty == g.leaderboardDifficulty) {
+				g.personalBests = append(g.personalBests, b)
+			}
+		}
+		g.leaderboardTotal = len(g.personalBests)
+		return
+	}
+	q := game.LeaderboardQuery{Subject: g.leaderboardSubject, Difficulty: g.leaderboardDifficulty, Limit: leaderboardPageRows}
+	switch g.leaderboardView {
+	case ViewWeek:
+		q.Since = game.WindowWeek.Since(time.Now())
+	case ViewMonth:
+		q.Since = game.WindowMonth.Since(time.Now())
+	case ViewClass:
+		host, err := g.store.ClassHost(g.userID, g.playerName)
+		if err != nil {
+			log.Printf("failed to look up class: %v", err)
+		}
+		if host == 0 {
+			g.leaderboardNote = "Host or join a class to see its board."
+			return
+		}
+		q.ClassHost = host
+	}
+	g.leaderboardQuery = &q
+	if g.userID > 0 {
+		rank, best, err := g.store.GetLeaderboardRank(q, g.userID)
+		if err != nil {
+			log.Printf("failed to load leaderboard rank: %v", err)
+		}
+		g.leaderboardRank, g.leaderboardBest = rank, best
+	}
+	g.fetchLeaderboardPage()
+}
+
+// fetchLeaderboardPage loads the rows of the battle board from the scroll offset.
+func (g *Game) fetchLeaderboardPage() {
+	if g.leaderboardQuery == nil {
+		return
+	}
+	g.leaderboardQuery.Offset = g.leaderboardOffset
+	page, err := g.store.GetLeaderboard(*g.leaderboardQuery)
+	if err != nil {
+		log.Printf("failed to load leaderboard: %v", err)
+	}
+	g.leaderboardEntries, g.leaderboardTotal = page.Entries, page.Total
+}
+
+// leaderboardPinned reports whether the player's best row is scrolled out of
+// view, so it is pinned to the bottom of the board instead
+func (g *Game) leaderboardPinned() bool {
+	if g.leaderboardView == ViewBests || g.leaderboardRank == 0 {
+		return false
+	}
+	return g.leaderboardRank <= g.leaderboardOffset || g.leaderboardRank > g.leaderboardOffset+leaderboardPageRows
+}
+
+// scrollLeaderboard moves the battle board by rows, keeping it within the list.
+func (g *Game) scrollLeaderboard(rows int) {
+	last := g.leaderboardTotal - leaderboardPageRows
+	if g.leaderboardRank > 0 {
+		// the pinned row takes the bottom line until the player's own row scrolls in
+		last++
+	}
+	offset := g.leaderboardOffset + rows
+	if offset > last {
+		offset = last
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	if offset == g.leaderboardOffset {
+		return
+	}
+	g.leaderboardOffset = offset
+	g.fetchLeaderboardPage()
+}
+
+// updateLeaderboardScroll scrolls the battle board with the mouse wheel, the
+// arrow keys, PAGE UP/DOWN and HOME/END.
+func (g *Game) updateLeaderboardScroll() {
+	if !g.showsLeaderboardTabs() {
+		return
+	}
+	_, dy := ebiten.Wheel()
+	g.leaderboardWheel -= dy
+	rows := int(g.leaderboardWheel)
+	g.leaderboardWheel -= float64(rows)
+	switch {
+	case inpututil.IsKeyJustPressed(ebiten.KeyDown):
+		rows++
+	case inpututil.IsKeyJustPressed(ebiten.KeyUp):
+		rows--
+	case inpututil.IsKeyJustPressed(ebiten.KeyPageDown):
+		rows += leaderboardPageRows
+	case inpututil.IsKeyJustPressed(ebiten.KeyPageUp):
+		rows -= leaderboardPageRows
+	case inpututil.IsKeyJustPressed(ebiten.KeyEnd):
+		rows = g.leaderboardTotal
+	case inpututil.IsKeyJustPressed(ebiten.KeyHome):
+		rows = -g.leaderboardTotal
+	}
+	if rows != 0 {
+		g.scrollLeaderboard(rows)
+	}
+}
+
+// battleBoardRows returns the columns and rows of the battle board's view, each
+// row numbered with its rank.
+func (g *Game) battleBoardRows() (headers []string, rows [][]string) {
+	if g.leaderboardView == ViewBests {
+		for i, b := range limitBests(g.personalBests, g.leaderboardOffset) {
+			rows = append(rows, []string{itoa(g.leaderboardOffset+i+1) + ". " + bestLabel(b), itoa(b.Score), itoa(b.Battles)})
+		}
+		return []string{"Battle", "Best", "Battles"}, rows
+	}
+	shown := leaderboardPageRows
+	if g.leaderboardPinned() {
+		shown--
+	}
+	for i, e := range g.leaderboardEntries {
+		if i == shown {
+			break
+		}
+		rows = append(rows, leaderboardRow(g.leaderboardOffset+i+1, e))
+	}
+	if g.leaderboardPinned() {
+		for len(rows) < shown {
+			rows = append(rows, []string{"", "", ""})
+		}
+		rows = append(rows, leaderboardRow(g.leaderboardRank, g.leaderboardBest))
+	}
+	return []string{"Name", "Score", "Streak"}, rows
+}
+
+// leaderboardRow is the battle board row of an entry at a rank
+func leaderboardRow(rank int, e game.LeaderboardEntry) []string {
+	return []string{itoa(rank) + ". " + strings.TrimSpace(e.PlayerName), itoa(e.Score), itoa(e.LongestStreak)}
+}
+
+// limitBests returns the page of personal bests from offset
+func limitBests(bests []game.PersonalBest, offset int) []game.PersonalBest {
+	if offset >= len(bests) {
+		return nil
+	}
+	bests = bests[offset:]
+	if len(bests) > leaderboardPageRows {
+		bests = bests[:leaderboardPageRows]
+	}
+	return bests
+}
+
+// isMyLeaderboardRow reports whether row i of the battle board is the player's
+func (g *Game) isMyLeaderboardRow(i int) bool {
+	if !g.showsLeaderboardTabs() || g.leaderboardView == ViewBests || g.userID <= 0 {
+		return false
+	}
+	if g.leaderboardPinned() {
+		if i == leaderboardPageRows-1 {
+			return true
+		}
+		if i >= leaderboardPageRows-1 {
+			return false
+		}
+	}
+	return i < len(g.leaderboardEntries) && g.leaderboardEntries[i].UserID == g.userID
+}
+
+// drawLeaderboardScrollbar draws where the shown rows are in the whole battle
+// board, in the gutter right of the table from top to bottom
+func (g *Game) drawLeaderboardScrollbar(screen *ebiten.Image, x, top, bottom float32) {
+	if !g.showsLeaderboardTabs() || g.leaderboardTotal <= leaderboardPageRows {
+		return
+	}
+	const w = 8
+	h := bottom - top
+	vector.DrawFilledRect(screen, x, top, w, h, color.RGBA{35, 35, 35, 200}, true)
+	thumbH := h * leaderboardPageRows / float32(g.leaderboardTotal)
+	thumbY := top + h*float32(g.leaderboardOffset)/float32(g.leaderboardTotal)
+	if thumbY+thumbH > bottom {
+		thumbY = bottom - thumbH
+	}
+	vector.DrawFilledRect(screen, x, thumbY, w, thumbH, VictoryGold, true)
+}
+
+// bestLabel names the battles a personal best was set in
+func bestLabel(b game.PersonalBest) string {
+	switch {
+	case b.Mode == game.ModeBoss:
+		return "Boss " + b.Difficulty
+	case b.Subject == "":
+		return "Earlier battles"
+	}
+	return b.Subject + " " + b.Difficulty
+}
+
+// filterLabel shows a filter's value, or All when it is not set
+func filterLabel(name, value string) string {
+	if value == "" {
+		value = "All"
+	}
+	return name + ": " + value
+}
+
+// nextFilter returns the option after value, going back to "" (all) after the last
+func nextFilter(options []string, value string) string {
+	for i, o := range options {
+		if o == value {
+			if i+1 < len(options) {
+				return options[i+1]
+			}
+			return ""
+		}
+	}
+	return options[0]
+}
+
+// drawLeaderboardTabs draws the view tabs, the filters and any note on the view.
+func (g *Game) drawLeaderboardTabs(screen *ebiten.Image) {
+	if !g.showsLeaderboardTabs() {
+		return
+	}
+	labels := append(append([]string(nil), leaderboardTabs...),
+		filterLabel("Subject", g.leaderboardSubject), filterLabel("Difficulty", g.leaderboardDifficulty))
+	for i, label := range labels {
+		r := leaderboardTabRect(i)
+		col := OceanTeal
+		if i == g.leaderboardView {
+			col = VictoryGold
+			vector.DrawFilledRect(screen, float32(r.Min.X), float32(r.Min.Y), float32(r.Dx()), float32(r.Dy()), color.RGBA{212, 175, 55, 80}, true)
+		} else if i == g.hoveredMenu {
+			col = SmokeWhite
+		}
+		vector.DrawFilledRect(screen, float32(r.Min.X), float32(r.Min.Y), float32(r.Dx()), float32(r.Dy()), color.RGBA{0, 31, 63, 200}, true)
+		vector.StrokeRect(screen, float32(r.Min.X), float32(r.Min.Y), float32(r.Dx()), float32(r.Dy()), 3, col, true)
+		bounds, _ := font.BoundString(g.confirmFont, label)
+		labelW := (bounds.Max.X - bounds.Min.X).Ceil()
+		drawWrappedTextWithShadow(screen, label, g.confirmFont, r.Min.X+(r.Dx()-labelW)/2, r.Min.Y+30, r.Dx(), 24, SmokeWhite)
+	}
+	note := g.leaderboardNote
+	if note == "" && g.leaderboardTotal > 0 {
+		last := g.leaderboardOffset + leaderboardPageRows
+		if g.leaderboardPinned() {
+			last--
+		}
+		if last > g.leaderboardTotal {
+			last = g.leaderboardTotal
+		}
+		note = "Showing " + itoa(g.leaderboardOffset+1) + "-" + itoa(last) + " of " + itoa(g.leaderboardTotal)
+		if g.leaderboardRank > 0 {
+			note += ", you are #" + itoa(g.leaderboardRank)
+		}
+	}
+	r := leaderboardTabRect(numViews + 1)
+	drawWrappedTextWithShadow(screen, note, g.confirmFont, r.Min.X, r.Max.Y+40, r.Dx(), 28, VictoryGold)
+}
+
+// updateLeaderboardTabs switches views with the tabs or LEFT/RIGHT, and cycles the
+// subject and difficulty filters with their buttons or F and D. It reports whether
+// a click landed on a tab, so the click does not also close the overlay.
+func (g *Game) updateLeaderboardTabs(mouseJustPressed bool) bool {
+	g.hoveredMenu = -1
+	if !g.showsLeaderboardTabs() {
+		return false
+	}
+	x, y := ebiten.CursorPosition()
+	for i := 0; i < numViews+2; i++ {
+		if image.Pt(x, y).In(leaderboardTabRect(i)) {
+			g.hoveredMenu = i
+		}
+	}
+	pick := -1
+	if mouseJustPressed {
+		pick = g.hoveredMenu
+	}
+	switch {
+	case inpututil.IsKeyJustPressed(ebiten.KeyRight):
+		pick = (g.leaderboardView + 1) % numViews
+	case inpututil.IsKeyJustPressed(ebiten.KeyLeft):
+		pick = (g.leaderboardView + numViews - 1) % numViews
+	case inpututil.IsKeyJustPressed(ebiten.KeyF):
+		pick = numViews
+	case inpututil.IsKeyJustPressed(ebiten.KeyD):
+		pick = numViews + 1
+	}
+	switch {
+	case pick < 0:
+		return false
+	case pick == numViews:
+		g.leaderboardSubject = nextFilter(g.quiz.ListSubjects(), g.leaderboardSubject)
+	case pick == numViews+1:
+		g.leaderboardDifficulty = nextFilter(game.Difficulties, g.leaderboardDifficulty)
+	default:
+		g.leaderboardView = pick
+	}
+	g.fetchBattleBoard()
+	return mouseJustPressed && g.hoveredMenu >= 0
+}
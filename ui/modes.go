@@ -0,0 +1,80 @@
+package ui
+
+import (
+	"github.com/RALPH22222/Broadside/game"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+// Entries of the game modes menu
+var modeOptions = []string{"Survival", "Time Attack", "Daily Challenge", "Multiplayer", "Back"}
+
+// Entries of the multiplayer menu
+var multiplayerOptions = []string{"Versus", "Fleet Battle", "Buzzer Party", "LAN Duel", "LAN Fleet", "Host Class", "Back"}
+
+func (g *Game) drawModeSelect(screen *ebiten.Image) {
+	g.drawMenuButtons(screen, "Game Modes", modeOptions, nil)
+}
+
+func (g *Game) updateModeSelect(mouseJustPressed bool) {
+	x, y := ebiten.CursorPosition()
+	g.hoveredMenu = hitRect(g.menuRects, x, y)
+	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+		g.state = StateMenu
+		return
+	}
+	if !mouseJustPressed || g.hoveredMenu < 0 {
+		return
+	}
+	switch modeOptions[g.hoveredMenu] {
+	case "Survival":
+		g.hoveredMenu = -1
+		g.state = StateSurvivalSelect
+	case "Time Attack":
+		g.mode = game.ModeTimeAttack
+		g.hoveredMenu = -1
+		g.state = StateSelectDifficulty
+	case "Daily Challenge":
+		g.openDaily()
+	case "Multiplayer":
+		g.hoveredMenu = -1
+		g.state = StateMultiplayerSelect
+	case "Back":
+		g.state = StateMenu
+	}
+}
+
+func (g *Game) drawMultiplayerSelect(screen *ebiten.Image) {
+	g.drawMenuButtons(screen, "Multiplayer", multiplayerOptions, nil)
+}
+
+func (g *Game) updateMultiplayerSelect(mouseJustPressed bool) {
+	x, y := ebiten.CursorPosition()
+	g.hoveredMenu = hitRect(g.menuRects, x, y)
+	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+		g.state = StateModeSelect
+		return
+	}
+	if !mouseJustPressed || g.hoveredMenu < 0 {
+		return
+	}
+	switch multiplayerOptions[g.hoveredMenu] {
+	case "Versus":
+		g.openVersus()
+	case "Fleet Battle":
+		g.openFleetSetup()
+	case "Buzzer Party":
+		g.openPartyLobby()
+	case "LAN Duel":
+		g.openLanConnect(false)
+	case "LAN Fleet":
+		g.openLanConnect(true)
+	case "Host Class":
+		g.mode = game.ModeClass
+		g.hoveredMenu = -1
+		g.state = StateSelectDifficulty
+	case "Back":
+		g.hoveredMenu = -1
+		g.state = StateModeSelect
+	}
+}
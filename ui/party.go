@@ -0,0 +1,300 @@
+package ui
+
+import (
+	"image"
+	"image/color"
+	"time"
+
+	"github.com/RALPH22222/Broadside/game"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// How long the answer of a question stays up before the next one
+const partyRevealTime = 2500 * time.Millisecond
+
+// openPartyLobby shows the lobby where players pick their buzzers and gamepads join.
+func (g *Game) openPartyLobby() {
+	if g.partyInput == nil {
+		g.partyInput = newPartyInput(game.PartyMinPlayers)
+	}
+	g.partyMsg = ""
+	g.hoveredMenu = -1
+	g.state = StatePartyLobby
+}
+
+func (g *Game) drawPartyLobby(screen *ebiten.Image) {
+	drawWrappedTextWithShadow(screen, "Buzzer Party", g.gameFont, 40, 60, ScreenWidth-80, 36, VictoryGold)
+	drawWrappedTextWithShadow(screen, "Everyone sees the question; the first to buzz answers. A miss lets the others buzz in.", g.confirmFont, 40, 100, ScreenWidth-80, 24, SmokeWhite)
+	for i, in := range g.partyInput.players {
+		y := partySeatRect(i).Min.Y
+		vector.DrawFilledRect(screen, practiceX, float32(y), practiceW, 64, GunmetalGray, true)
+		vector.StrokeRect(screen, practiceX, float32(y), practiceW, 64, 3, partyColors[i], true)
+		drawWrappedTextWithShadow(screen, partyName(i), g.gameFont, practiceX+20, y+42, 300, 36, partyColors[i])
+		seat := "Buzzer: " + in.label()
+		if in.captain >= 0 {
+			seat = seatLabel(in.captain)
+		}
+		drawWrappedTextWithShadow(screen, seat, g.gameFont, practiceX+320, y+42, practiceW-340, 36, SmokeWhite)
+	}
+	hint := "Press any button on a gamepad to hand it to the next keyboard player. Keyboard players answer with 1-3 or the mouse, gamepads with A/B/X. Click a seat to hand it to a computer captain."
+	drawWrappedTextWithShadow(screen, hint, g.confirmFont, practiceX, 520, practiceW, 24, OceanTeal)
+	drawWrappedTextWithShadow(screen, g.partyMsg, g.confirmFont, practiceX, 590, practiceW, 24, VictoryGold)
+	n := len(g.partyInput.players)
+	g.drawButtonRow(screen, []string{"- Player", "+ Player", "Start", "Back"}, []bool{n <= game.PartyMinPlayers, n >= game.PartyMaxPlayers})
+}
+
+func (g *Game) updatePartyLobby(mouseJustPressed bool) {
+	if p := g.partyInput.joinGamepads(); p >= 0 {
+		g.partyMsg = partyName(p) + " buzzes with " + g.partyInput.players[p].label() + "."
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+		g.state = StateMultiplayerSelect
+		return
+	}
+	x, y := ebiten.CursorPosition()
+	g.hoveredMenu = hitRect(g.buttonRects, x, y)
+	if !mouseJustPressed {
+		return
+	}
+	for i := range g.partyInput.players {
+		if image.Pt(x, y).In(partySeatRect(i)) {
+			in := &g.partyInput.players[i]
+			in.captain, in.hasPad = nextCaptain(in.captain), false
+		}
+	}
+	n := len(g.partyInput.players)
+	switch g.hoveredMenu {
+	case 0:
+		if n > game.PartyMinPlayers {
+			g.partyInput.resize(n - 1)
+		}
+	case 1:
+		if n < game.PartyMaxPlayers {
+			g.partyInput.resize(n + 1)
+		}
+	case 2:
+		g.mode = game.ModeParty
+		g.hoveredMenu = -1
+		g.state = StateSelectDifficulty
+	case 3:
+		g.state = StateMultiplayerSelect
+	}
+}
+
+// partySeatRect is the lobby row of a seat
+func partySeatRect(i int) image.Rectangle {
+	return image.Rect(practiceX, 170+i*80, practiceX+practiceW, 234+i*80)
+}
+
+// Colors telling the players apart on the scoreboard and the podium
+var partyColors = []color.RGBA{VictoryGold, OceanTeal, AlertRed, SmokeWhite}
+
+func partyName(i int) string {
+	return "Player " + itoa(i+1)
+}
+
+// startParty loads mixed-subject questions for the players in the lobby.
+func (g *Game) startParty(difficulty string) {
+	level := game.InitCombatState(difficulty).Level
+	names := make([]string, len(g.partyInput.players))
+	for i, in := range g.partyInput.players {
+		names[i] = partyName(i)
+		if in.captain >= 0 {
+			names[i] = game.Personalities[in.captain].Name
+		}
+	}
+	g.party = game.NewPartyGame(names, level)
+	for i, in := range g.partyInput.players {
+		if in.captain >= 0 {
+			g.party.Players[i].Seat = newCaptain(in.captain)
+		}
+	}
+	g.level = level
+	g.selectedDifficulty = difficulty
+	questions := g.quiz.SelectQuestions(g.quiz.ListSubjects(), difficulty, game.PartyQuestions)
+	g.quizQuestions = make([]QuizQuestion, 0, len(questions))
+	for _, q := range questions {
+		g.quizQuestions = append(g.quizQuestions, QuizQuestion{
+			Question:    q.Text,
+			Options:     q.Choices,
+			Answer:      q.AnswerIndex(),
+			Explanation: q.Explanation,
+			Subject:     q.Subject,
+		})
+	}
+	g.currentQ = 0
+	g.selectedAns = -1
+	g.answerRects = nil
+	g.partyRevealed = false
+	g.partyMsg = ""
+	g.openPartyQuestion()
+	g.state = StateParty
+}
+
+// openPartyQuestion opens the buzzers; computer seats decide afresh.
+func (g *Game) openPartyQuestion() {
+	g.partyClock = time.Now()
+	g.partyQuestionTime = g.partyClock
+	g.partyPlans = make([]captainPlan, len(g.party.Players))
+}
+
+// revealParty shows the answer of the current question with msg.
+func (g *Game) revealParty(msg string) {
+	g.partyRevealed = true
+	g.partyClock = time.Now()
+	g.partyMsg = msg
+}
+
+// answerParty scores the buzzing player's pick; choice is -1 when they ran out of time.
+func (g *Game) answerParty(choice int) {
+	q := g.quizQuestions[g.currentQ]
+	who := g.party.Players[g.party.Buzzed].Name
+	correct := choice == q.Answer
+	points, open := g.party.Answer(correct)
+	switch {
+	case correct:
+		g.selectedAns = choice
+		g.revealParty(who + " is right! +" + itoa(points))
+	case open:
+		g.partyClock = time.Now()
+		g.partyMsg = who + " missed (" + itoa(points) + "). Anyone else?"
+	default:
+		g.revealParty(who + " missed (" + itoa(points) + "). Nobody got it.")
+	}
+}
+
+func (g *Game) updateParty(mouseJustPressed bool) {
+	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) || g.currentQ >= len(g.quizQuestions) {
+		g.finishParty()
+		return
+	}
+	g.partyInput.joinGamepads()
+	switch {
+	case g.partyRevealed:
+		if time.Since(g.partyClock) < partyRevealTime && !inpututil.IsKeyJustPressed(ebiten.KeyEnter) {
+			return
+		}
+		g.currentQ++
+		g.selectedAns = -1
+		g.partyRevealed = false
+		g.partyMsg = ""
+		g.party.NextQuestion()
+		g.openPartyQuestion()
+		if g.currentQ >= len(g.quizQuestions) {
+			g.finishParty()
+		}
+	case g.updatePartyCaptains():
+	case g.party.Buzzed < 0:
+		if p := g.partyInput.buzzer(g.party.LockedOut); p >= 0 && g.party.Buzz(p) {
+			g.partyClock = time.Now()
+			g.partyMsg = g.party.Players[p].Name + " buzzed in!"
+		} else if time.Since(g.partyClock) > game.PartyBuzzWindow {
+			g.revealParty("Nobody buzzed in.")
+		}
+	case game.IsComputer(g.party.Players[g.party.Buzzed].Seat):
+		// Wait for the computer captain holding the buzzer
+	default:
+		choice := g.partyInput.players[g.party.Buzzed].choice(len(g.answerRects))
+		if choice < 0 {
+			choice = g.pickedChoice(mouseJustPressed)
+		}
+		if choice >= 0 {
+			g.answerParty(choice)
+		} else if time.Since(g.partyClock) > game.PartyAnswerTime {
+			g.answerParty(-1)
+		}
+	}
+}
+
+func (g *Game) drawParty(screen *ebiten.Image) {
+	// Scoreboard: the buzzing player is lit up, players who missed are dimmed
+	boxW := (ScreenWidth - 80 - 16*(len(g.party.Players)-1)) / len(g.party.Players)
+	for i, p := range g.party.Players {
+		x := 40 + i*(boxW+16)
+		border, bg := partyColors[i], GunmetalGray
+		if g.party.Buzzed == i {
+			bg = NavyBlue
+		} else if g.party.LockedOut[i] {
+			border = GunmetalGray
+		}
+		vector.DrawFilledRect(screen, float32(x), 24, float32(boxW), 80, bg, true)
+		vector.StrokeRect(screen, float32(x), 24, float32(boxW), 80, 3, border, true)
+		drawWrappedTextWithShadow(screen, p.Name+": "+itoa(p.Score), g.gameFont, x+12, 60, boxW-24, 36, partyColors[i])
+		drawWrappedTextWithShadow(screen, g.partyInput.players[i].label(), g.confirmFont, x+12, 92, boxW-24, 24, SmokeWhite)
+	}
+	if g.currentQ >= len(g.quizQuestions) {
+		return
+	}
+	q := g.quizQuestions[g.currentQ]
+	progress := "Question " + itoa(g.currentQ+1) + "/" + itoa(len(g.quizQuestions))
+	drawWrappedTextWithShadow(screen, progress, g.confirmFont, 40, 140, 300, 24, SmokeWhite)
+
+	y := 190
+	drawWrappedTextWithShadow(screen, q.Question, g.gameFont, practiceX, y, practiceW, 36, SmokeWhite)
+	y += len(wrapText(g.gameFont, q.Question, practiceW))*36 + 12
+	y = g.drawChoiceList(screen, q, y, g.partyRevealed)
+
+	status, col := "", SmokeWhite
+	switch {
+	case g.partyRevealed:
+		if q.Explanation != "" {
+			drawWrappedTextWithShadow(screen, q.Explanation, g.confirmFont, practiceX, y+60, practiceW, 24, SmokeWhite)
+		}
+	case g.party.Buzzed >= 0:
+		left := game.PartyAnswerTime - time.Since(g.partyClock)
+		status, col = g.party.Players[g.party.Buzzed].Name+", answer! "+itoa(int(left.Seconds())+1)+"s", partyColors[g.party.Buzzed]
+	default:
+		left := game.PartyBuzzWindow - time.Since(g.partyClock)
+		status = "Buzz in! " + itoa(int(left.Seconds())+1) + "s"
+	}
+	drawWrappedTextWithShadow(screen, status, g.gameFont, practiceX, y+24, practiceW, 36, col)
+	drawWrappedTextWithShadow(screen, g.partyMsg, g.gameFont, practiceX, ScreenHeight-60, practiceW, 36, VictoryGold)
+}
+
+// finishParty shows the podium.
+func (g *Game) finishParty() {
+	g.hoveredMenu = -1
+	g.state = StatePartyPodium
+}
+
+func (g *Game) drawPartyPodium(screen *ebiten.Image) {
+	drawWrappedTextWithShadow(screen, "Podium", g.gameFont, 40, 60, ScreenWidth-80, 36, VictoryGold)
+	order := g.party.Podium()
+	// Second place stands left of the winner, third on the right
+	steps := []struct{ x, h int }{{412, 240}, {172, 180}, {652, 130}}
+	base := ScreenHeight - 140
+	for place, idx := range order {
+		p := g.party.Players[idx]
+		if place >= len(steps) {
+			line := itoa(place+1) + "th: " + p.Name + " - " + itoa(p.Score) + " pts"
+			drawWrappedTextWithShadow(screen, line, g.confirmFont, 40, 100+(place-len(steps))*28, ScreenWidth-80, 24, SmokeWhite)
+			continue
+		}
+		s := steps[place]
+		top := base - s.h
+		vector.DrawFilledRect(screen, float32(s.x), float32(top), 200, float32(s.h), GunmetalGray, true)
+		vector.StrokeRect(screen, float32(s.x), float32(top), 200, float32(s.h), 4, partyColors[idx], true)
+		drawWrappedTextWithShadow(screen, itoa(place+1), g.gameFont, s.x+88, top+48, 40, 36, VictoryGold)
+		drawWrappedTextWithShadow(screen, itoa(p.Score)+" pts", g.gameFont, s.x+16, top+96, 168, 36, SmokeWhite)
+		drawWrappedTextWithShadow(screen, itoa(p.Correct)+" right, "+itoa(p.Wrong)+" wrong", g.confirmFont, s.x+16, top+128, 168, 24, SmokeWhite)
+		drawWrappedTextWithShadow(screen, p.Name, g.gameFont, s.x+16, top-16, 200, 36, partyColors[idx])
+	}
+	g.drawButtonRow(screen, []string{"Play Again", "Main Menu"}, nil)
+}
+
+func (g *Game) updatePartyPodium(mouseJustPressed bool) {
+	x, y := ebiten.CursorPosition()
+	g.hoveredMenu = hitRect(g.buttonRects, x, y)
+	if !mouseJustPressed {
+		return
+	}
+	switch g.hoveredMenu {
+	case 0:
+		g.startParty(g.selectedDifficulty)
+	case 1:
+		g.mode = game.ModeStandard
+		g.state = StateMenu
+	}
+}
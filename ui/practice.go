@@ -0,0 +1,209 @@
+package ui
+
+import (
+	"image"
+	"log"
+
+	"github.com/RALPH22222/Broadside/game"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+	"golang.org/x/image/font"
+)
+
+// Practice screen layout
+const (
+	practiceX = 112
+	practiceW = ScreenWidth - 224
+)
+
+// startPractice draws the same questions as a battle but without HP, shields or a timer.
+func (g *Game) startPractice(subject, difficulty string) {
+	level := game.InitCombatState(difficulty).Level
+	questions := g.quiz.SelectQuestions([]string{subject}, difficulty, game.GetMainQuestionsCount(level)+1)
+	g.quizQuestions = make([]QuizQuestion, 0, len(questions))
+	for _, q := range questions {
+		g.quizQuestions = append(g.quizQuestions, QuizQuestion{
+			Question:    q.Text,
+			Options:     q.Choices,
+			Answer:      q.AnswerIndex(),
+			Hint:        q.Hint,
+			Explanation: q.Explanation,
+		})
+	}
+	g.practice = &game.PracticeSession{Subject: subject, Difficulty: difficulty}
+	g.currentQ = 0
+	g.selectedAns = -1
+	g.practiceAnswered = false
+	g.answerRects = nil
+	g.state = StatePractice
+}
+
+// finishPractice saves the practice run and shows its summary.
+func (g *Game) finishPractice() {
+	if g.userID > 0 && g.practice.Total() > 0 {
+		if err := g.store.SavePracticeSession(g.userID, g.practice); err != nil {
+			log.Printf("failed to save practice session: %v", err)
+		}
+	}
+	g.hoveredMenu = -1
+	g.state = StatePracticeResults
+}
+
+func (g *Game) drawPractice(screen *ebiten.Image) {
+	p := g.practice
+	header := "Practice: " + p.Subject + " (" + p.Difficulty + ")"
+	drawWrappedTextWithShadow(screen, header, g.gameFont, 40, 60, ScreenWidth-80, 36, VictoryGold)
+	tally := "Correct: " + itoa(p.Correct) + "   Incorrect: " + itoa(p.Incorrect) + "   Skipped: " + itoa(p.Skipped)
+	drawWrappedTextWithShadow(screen, tally, g.confirmFont, 40, 100, ScreenWidth-80, 24, SmokeWhite)
+	if g.currentQ >= len(g.quizQuestions) {
+		return
+	}
+	q := g.quizQuestions[g.currentQ]
+	progress := "Question " + itoa(g.currentQ+1) + "/" + itoa(len(g.quizQuestions))
+	drawWrappedTextWithShadow(screen, progress, g.confirmFont, ScreenWidth-240, 100, 200, 24, SmokeWhite)
+
+	// Question
+	y := 170
+	qLines := wrapText(g.gameFont, q.Question, practiceW)
+	drawWrappedTextWithShadow(screen, q.Question, g.gameFont, practiceX, y, practiceW, 36, SmokeWhite)
+	y += len(qLines)*36 + 12
+
+	y = g.drawChoiceList(screen, q, y, g.practiceAnswered)
+
+	// The correct answer and its explanation
+	if g.practiceAnswered {
+		y += 12
+		verdict, col := "Not quite.", AlertRed
+		if g.selectedAns == q.Answer {
+			verdict, col = "Correct!", VictoryGold
+		} else if g.selectedAns < 0 {
+			verdict, col = "Skipped.", SmokeWhite
+		}
+		if q.Answer >= 0 && q.Answer < len(q.Options) {
+			verdict += " The answer is " + q.Options[q.Answer] + "."
+		}
+		drawWrappedTextWithShadow(screen, verdict, g.gameFont, practiceX, y+24, practiceW, 36, col)
+		if q.Explanation != "" {
+			drawWrappedTextWithShadow(screen, q.Explanation, g.confirmFont, practiceX, y+64, practiceW, 24, SmokeWhite)
+		} else if q.Hint != "" {
+			drawWrappedTextWithShadow(screen, "Tip: "+q.Hint, g.confirmFont, practiceX, y+64, practiceW, 24, SmokeWhite)
+		}
+	}
+
+	// Skip/Next and End buttons
+	first := "Skip (S)"
+	if g.practiceAnswered {
+		first = "Next (Enter)"
+	}
+	g.drawButtonRow(screen, []string{first, "End (Esc)"}, nil)
+}
+
+// drawChoiceList draws the choices of q as buttons starting at y and returns the y below them.
+// Once revealed, the correct choice is marked and a wrong pick is outlined in red.
+func (g *Game) drawChoiceList(screen *ebiten.Image, q QuizQuestion, y int, revealed bool) int {
+	g.answerRects = g.answerRects[:0]
+	for i, opt := range q.Options {
+		rect := image.Rect(practiceX, y, practiceX+practiceW, y+48)
+		bgCol, borderCol := GunmetalGray, VictoryGold
+		if revealed {
+			switch {
+			case i == q.Answer:
+				bgCol = OceanTeal
+			case i == g.selectedAns:
+				borderCol = AlertRed
+			default:
+				borderCol = GunmetalGray
+			}
+		}
+		vector.DrawFilledRect(screen, float32(rect.Min.X), float32(rect.Min.Y), float32(rect.Dx()), float32(rect.Dy()), bgCol, true)
+		vector.StrokeRect(screen, float32(rect.Min.X), float32(rect.Min.Y), float32(rect.Dx()), float32(rect.Dy()), 3, borderCol, true)
+		label := itoa(i+1) + ". " + opt
+		bounds, _ := font.BoundString(g.gameFont, label)
+		labelW := (bounds.Max.X - bounds.Min.X).Ceil()
+		drawWrappedTextWithShadow(screen, label, g.gameFont, rect.Min.X+(rect.Dx()-labelW)/2, y+33, practiceW, 36, SmokeWhite)
+		g.answerRects = append(g.answerRects, rect)
+		y += 60
+	}
+	return y
+}
+
+// pickedChoice returns the choice clicked or chosen with the 1-3 keys, or -1.
+func (g *Game) pickedChoice(mouseJustPressed bool) int {
+	choice := -1
+	if mouseJustPressed {
+		x, y := ebiten.CursorPosition()
+		choice = hitRect(g.answerRects, x, y)
+	}
+	for i := range g.answerRects {
+		if inpututil.IsKeyJustPressed(ebiten.Key1 + ebiten.Key(i)) {
+			choice = i
+		}
+	}
+	return choice
+}
+
+func (g *Game) updatePractice(mouseJustPressed bool) {
+	x, y := ebiten.CursorPosition()
+	g.hoveredMenu = hitRect(g.buttonRects, x, y)
+	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) || (mouseJustPressed && g.hoveredMenu == 1) {
+		g.finishPractice()
+		return
+	}
+	if g.currentQ >= len(g.quizQuestions) {
+		g.finishPractice()
+		return
+	}
+	advance := (mouseJustPressed && g.hoveredMenu == 0) || inpututil.IsKeyJustPressed(ebiten.KeyEnter) || inpututil.IsKeyJustPressed(ebiten.KeyS)
+	if g.practiceAnswered {
+		if advance {
+			g.currentQ++
+			g.selectedAns = -1
+			g.practiceAnswered = false
+			if g.currentQ >= len(g.quizQuestions) {
+				g.finishPractice()
+			}
+		}
+		return
+	}
+	// Skipping still reveals the answer before moving on
+	if (mouseJustPressed && g.hoveredMenu == 0) || inpututil.IsKeyJustPressed(ebiten.KeyS) {
+		g.practice.Skip()
+		g.selectedAns = -1
+		g.practiceAnswered = true
+		return
+	}
+	if choice := g.pickedChoice(mouseJustPressed); choice >= 0 {
+		g.selectedAns = choice
+		g.practiceAnswered = true
+		g.practice.Answer(choice == g.quizQuestions[g.currentQ].Answer)
+	}
+}
+
+func (g *Game) drawPracticeResults(screen *ebiten.Image) {
+	p := g.practice
+	lines := []string{
+		p.Subject + " (" + p.Difficulty + ")",
+		"Correct: " + itoa(p.Correct) + "   Incorrect: " + itoa(p.Incorrect) + "   Skipped: " + itoa(p.Skipped),
+		"Accuracy: " + itoa(p.Accuracy()) + "% of answered questions",
+	}
+	for i, line := range lines {
+		drawWrappedTextWithShadow(screen, line, g.gameFont, ScreenWidth/10, ScreenHeight/8+60+i*40, ScreenWidth*8/10, 36, SmokeWhite)
+	}
+	g.drawMenuButtons(screen, "Practice Results", []string{"Practice Again", "Main Menu"}, nil)
+}
+
+func (g *Game) updatePracticeResults(mouseJustPressed bool) {
+	x, y := ebiten.CursorPosition()
+	g.hoveredMenu = hitRect(g.menuRects, x, y)
+	if !mouseJustPressed {
+		return
+	}
+	switch g.hoveredMenu {
+	case 0:
+		g.startPractice(g.practice.Subject, g.practice.Difficulty)
+	case 1:
+		g.mode = game.ModeStandard
+		g.state = StateMenu
+	}
+}
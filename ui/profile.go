@@ -0,0 +1,74 @@
+package ui
+
+import (
+	"log"
+	"math"
+
+	"github.com/RALPH22222/Broadside/game"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+// openProfile loads the signed-in captain's total score and skill ratings.
+func (g *Game) openProfile() {
+	g.profileRatings = nil
+	g.profileScore = 0
+	if g.userID > 0 {
+		ratings, err := g.store.GetRatings(g.userID)
+		if err != nil {
+			log.Printf("failed to load ratings: %v", err)
+		}
+		g.profileRatings = ratings
+		total, err := g.store.GetTotalScore(g.userID)
+		if err != nil {
+			log.Printf("failed to load total score: %v", err)
+		}
+		g.profileScore = total
+	}
+	g.hoveredMenu = -1
+	g.state = StateProfile
+}
+
+func (g *Game) drawProfile(screen *ebiten.Image) {
+	title := "Captain " + g.playerName
+	if g.userID <= 0 {
+		title = "No captain signed in"
+	}
+	drawWrappedTextWithShadow(screen, title, g.gameFont, practiceX, 80, practiceW, 36, VictoryGold)
+	drawWrappedTextWithShadow(screen, "Total score: "+itoa(g.profileScore), g.gameFont, practiceX, 130, practiceW, 36, SmokeWhite)
+	drawWrappedTextWithShadow(screen, "Skill ratings", g.gameFont, practiceX, 200, practiceW, 36, OceanTeal)
+	if len(g.profileRatings) == 0 {
+		msg := "Finish a battle to get your first rating. Each battle counts once per subject, your accuracy against the difficulty of its questions, and versus matches against the other captain."
+		drawWrappedTextWithShadow(screen, msg, g.confirmFont, practiceX, 244, practiceW, 24, SmokeWhite)
+	}
+	for i, r := range g.profileRatings {
+		y := 244 + i*32
+		if y > ScreenHeight-140 {
+			break
+		}
+		line := r.Subject + ": " + itoa(int(math.Round(r.Rating))) + " (" + itoa(r.Games) + " battles"
+		if r.Provisional() {
+			line += ", provisional"
+		}
+		drawWrappedTextWithShadow(screen, line+")", g.confirmFont, practiceX, y, practiceW, 24, SmokeWhite)
+	}
+	g.drawButtonRow(screen, []string{"Back"}, nil)
+}
+
+func (g *Game) updateProfile(mouseJustPressed bool) {
+	x, y := ebiten.CursorPosition()
+	g.hoveredMenu = hitRect(g.buttonRects, x, y)
+	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) || (mouseJustPressed && g.hoveredMenu == 0) {
+		g.state = StateMenu
+	}
+}
+
+// saveRatings rates the answers of the finished battle for the signed-in captain.
+func (g *Game) saveRatings() {
+	if g.userID <= 0 || len(g.answerRecords) == 0 {
+		return
+	}
+	if err := game.UpdateRatings(g.store, g.userID, g.selectedSubject, g.answerRecords); err != nil {
+		log.Printf("failed to save ratings: %v", err)
+	}
+}
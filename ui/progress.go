@@ -0,0 +1,87 @@
+package ui
+
+import (
+	"image"
+	"log"
+
+	"github.com/RALPH22222/Broadside/game"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// loadProgress loads the signed-in captain's completions and unlocks. Guests start
+// from scratch and keep their progress only until they close the game.
+func (g *Game) loadProgress() {
+	g.progress = game.NewProgress()
+	if g.userID > 0 {
+		p, err := game.LoadProgress(g.store, g.userID)
+		if err != nil {
+			log.Printf("failed to load progress: %v", err)
+		}
+		g.progress = p
+	}
+	g.unlockedDifficulties = make(map[string]bool)
+	for _, d := range game.Difficulties {
+		if g.progress.Unlocked(d) {
+			g.unlockedDifficulties[d] = true
+		}
+	}
+	g.answeredSubjects = make(map[string]bool)
+}
+
+// saveStars records the stars of a finished standard battle that completed its subject.
+func (g *Game) saveStars() {
+	if g.mode != game.ModeStandard || g.starCount < game.StarsToComplete {
+		return
+	}
+	g.progress.Complete(g.selectedDifficulty, g.selectedSubject, g.starCount)
+	if g.userID <= 0 {
+		return
+	}
+	if err := g.store.MarkDifficultyCompleted(g.userID, g.selectedSubject, g.selectedDifficulty, g.starCount); err != nil {
+		log.Printf("failed to save progress: %v", err)
+	}
+}
+
+// drawDifficultyMark shows beside a difficulty button whether its boss is beaten,
+// or else how many of its subjects are done.
+func (g *Game) drawDifficultyMark(screen *ebiten.Image, difficulty string, rect image.Rectangle) {
+	if g.mode != game.ModeStandard {
+		return
+	}
+	mark, col := "", SmokeWhite
+	if g.progress.Cleared(difficulty) {
+		mark, col = "Cleared", VictoryGold
+	} else if done := len(g.progress.Subjects(difficulty)); done > 0 {
+		mark = itoa(done) + "/" + itoa(len(g.quiz.ListSubjects())) + " subjects"
+	}
+	drawWrappedTextWithShadow(screen, mark, g.confirmFont, rect.Max.X+20, rect.Min.Y+rect.Dy()/2+8, ScreenWidth-rect.Max.X-30, 24, col)
+}
+
+// drawSubjectMark shows the best stars of a completed subject beside its button.
+func (g *Game) drawSubjectMark(screen *ebiten.Image, subject string, rect image.Rectangle) {
+	if g.mode != game.ModeStandard {
+		return
+	}
+	if stars := g.progress.BestStars(g.selectedDifficulty, subject); stars > 0 {
+		g.drawStars(screen, stars, rect.Max.X+16, rect.Min.Y, rect.Dy())
+	}
+}
+
+// drawStars draws a frame of the star strip, which runs from three stars down to
+// none in half-star steps, as a size by size square.
+func (g *Game) drawStars(screen *ebiten.Image, stars float64, x, y, size int) {
+	if g.starStripImg == nil {
+		return
+	}
+	frame := int((3 - stars) * 2)
+	if frame > 5 {
+		frame = 5
+	}
+	imgW := g.starStripImg.Bounds().Dx()
+	frameH := g.starStripImg.Bounds().Dy() / 6
+	frameImg := g.starStripImg.SubImage(image.Rect(0, frame*frameH, imgW, (frame+1)*frameH)).(*ebiten.Image)
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Scale(float64(size)/float64(imgW), float64(size)/float64(frameH))
+	op.GeoM.Translate(float64(x), float64(y))
+	screen.DrawImage(frameImg, op)
+}
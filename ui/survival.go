@@ -0,0 +1,137 @@
+package ui
+
+import (
+	"log"
+	"strings"
+
+	"github.com/RALPH22222/Broadside/game"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+// survivalSubjectOptions lists the subject filters for a survival run
+func (g *Game) survivalSubjectOptions() []string {
+	options := append([]string{"All Subjects"}, g.quiz.ListSubjects()...)
+	return append(options, "Back")
+}
+
+func (g *Game) drawSurvivalSelect(screen *ebiten.Image) {
+	g.drawMenuButtons(screen, "Survival: choose your waters", g.survivalSubjectOptions(), nil)
+}
+
+func (g *Game) updateSurvivalSelect(mouseJustPressed bool) {
+	options := g.survivalSubjectOptions()
+	x, y := ebiten.CursorPosition()
+	g.hoveredMenu = hitRect(g.menuRects, x, y)
+	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) || (mouseJustPressed && g.hoveredMenu == len(options)-1) {
+		g.state = StateModeSelect
+		return
+	}
+	if !mouseJustPressed || g.hoveredMenu < 0 {
+		return
+	}
+	if g.hoveredMenu == 0 {
+		g.startSurvival(g.quiz.ListSubjects())
+	} else {
+		g.startSurvival([]string{options[g.hoveredMenu]})
+	}
+}
+
+// startSurvival begins an endless run on Easy. There is no bonus question and no
+// fixed question count: questions are drawn as the run goes on.
+func (g *Game) startSurvival(subjects []string) {
+	g.mode = game.ModeSurvival
+	g.survivalSubjects = subjects
+	g.survivalWaves = 0
+	g.startCombat(nil, levelNames[game.LevelFG])
+	g.selectedDifficulty = levelNames[game.LevelFG]
+	g.selectedSubject = strings.Join(subjects, ",")
+	g.enemyMaxHP = game.SurvivalEnemyHP(g.level)
+	g.enemyHP = g.enemyMaxHP
+	g.quizQuestions = nil
+	g.bonusQIndex = -1
+	g.refillSurvivalQuestions()
+	g.state = StatePlaying
+}
+
+// refillSurvivalQuestions tops up the question list at the current level
+// once the player reaches its last question.
+func (g *Game) refillSurvivalQuestions() {
+	if g.currentQ < len(g.quizQuestions)-1 {
+		return
+	}
+	for _, q := range g.quiz.SelectQuestions(g.survivalSubjects, levelNames[g.level], -1) {
+		g.quizQuestions = append(g.quizQuestions, QuizQuestion{
+			Question: q.Text,
+			Options:  q.Choices,
+			Answer:   q.AnswerIndex(),
+			Hint:     q.Hint,
+			Subject:  q.Subject,
+		})
+	}
+}
+
+// nextSurvivalWave respawns the enemy after a sinking. Every few waves the
+// questions step up a difficulty, and shields regenerate only on milestones.
+func (g *Game) nextSurvivalWave() {
+	g.survivalWaves++
+	level := game.SurvivalLevel(g.survivalWaves)
+	msg := "Enemy sunk! Wave " + itoa(g.survivalWaves+1) + " approaches."
+	if level != g.level {
+		g.level = level
+		g.selectedDifficulty = levelNames[level]
+		// Drop the rest of the easier questions
+		g.quizQuestions = g.quizQuestions[:g.currentQ+1]
+		g.refillSurvivalQuestions()
+		msg = "Enemy sunk! " + game.GetEnemyClass(level).Name + "s approach: " + levelNames[level] + " questions."
+	}
+	if game.IsShieldMilestone(g.survivalWaves) {
+		g.playerShields = g.playerMaxShields
+		msg += " Shields restored!"
+	}
+	g.enemyMaxHP = game.SurvivalEnemyHP(g.level)
+	g.enemyHP = g.enemyMaxHP
+	g.combatOver = false
+	g.lastEnemyAction = msg
+}
+
+// finishSurvival saves the run to the survival leaderboard and shows the results.
+func (g *Game) finishSurvival() {
+	if g.userID > 0 && g.score > 0 {
+		if err := g.store.InsertSurvivalRun(g.userID, g.score, g.survivalWaves, g.selectedSubject); err != nil {
+			log.Printf("failed to save survival run: %v", err)
+		}
+	}
+	g.saveAttempts()
+	g.saveRatings()
+	g.showFeedback = false
+	g.selectedAns = -1
+	g.hoveredMenu = -1
+	g.state = StateSurvivalResults
+}
+
+func (g *Game) drawSurvivalResults(screen *ebiten.Image) {
+	lines := []string{
+		"Sunk after " + itoa(g.survivalWaves) + " waves (" + levelNames[g.level] + " waters)",
+		"Score: " + itoa(g.score) + "   Best streak: " + itoa(g.longestStreak),
+	}
+	for i, line := range lines {
+		drawWrappedTextWithShadow(screen, line, g.gameFont, ScreenWidth/10, ScreenHeight/8+60+i*40, ScreenWidth*8/10, 36, SmokeWhite)
+	}
+	g.drawMenuButtons(screen, "Survival Results", []string{"Sail Again", "Main Menu"}, nil)
+}
+
+func (g *Game) updateSurvivalResults(mouseJustPressed bool) {
+	x, y := ebiten.CursorPosition()
+	g.hoveredMenu = hitRect(g.menuRects, x, y)
+	if !mouseJustPressed {
+		return
+	}
+	switch g.hoveredMenu {
+	case 0:
+		g.startSurvival(g.survivalSubjects)
+	case 1:
+		g.mode = game.ModeStandard
+		g.state = StateMenu
+	}
+}
@@ -0,0 +1,139 @@
+package ui
+
+import (
+	"log"
+	"time"
+
+	"github.com/RALPH22222/Broadside/game"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+// startTimeAttack starts the blitz with questions from every subject at the given difficulty.
+func (g *Game) startTimeAttack(difficulty string) {
+	g.quizQuestions = nil
+	g.answerRecords = nil
+	g.currentQ = 0
+	g.refillTimeAttackQuestions(difficulty)
+	g.selectedAns = -1
+	g.answerRects = nil
+	g.timeAttackFlash = ""
+	g.timeAttack = game.NewTimeAttackRun(difficulty, time.Now())
+	g.state = StateTimeAttack
+}
+
+// refillTimeAttackQuestions reshuffles the mixed question pool once it runs out.
+func (g *Game) refillTimeAttackQuestions(difficulty string) {
+	if g.currentQ < len(g.quizQuestions) {
+		return
+	}
+	for _, q := range g.quiz.SelectQuestions(g.quiz.ListSubjects(), difficulty, -1) {
+		g.quizQuestions = append(g.quizQuestions, QuizQuestion{
+			Question: q.Text,
+			Options:  q.Choices,
+			Answer:   q.AnswerIndex(),
+			Subject:  q.Subject,
+		})
+	}
+}
+
+// finishTimeAttack saves the run to the time-attack leaderboard and shows the results.
+func (g *Game) finishTimeAttack() {
+	if g.userID > 0 && g.timeAttack.Correct+g.timeAttack.Wrong > 0 {
+		if err := g.store.InsertTimeAttack(g.userID, g.timeAttack); err != nil {
+			log.Printf("failed to save time attack: %v", err)
+		}
+	}
+	g.saveAttempts()
+	g.saveRatings()
+	g.hoveredMenu = -1
+	g.state = StateTimeAttackResults
+}
+
+func (g *Game) drawTimeAttack(screen *ebiten.Image) {
+	run := g.timeAttack
+	remaining := run.Remaining(time.Now())
+	clockCol := SmokeWhite
+	if remaining <= 10*time.Second {
+		clockCol = AlertRed
+	} else if remaining <= 30*time.Second {
+		clockCol = VictoryGold
+	}
+	secs := int(remaining.Seconds())
+	clock := itoa(secs/60) + ":" + itoa(secs%60/10) + itoa(secs%10)
+	drawWrappedTextWithShadow(screen, "Time Attack ("+run.Difficulty+")", g.gameFont, 40, 60, ScreenWidth-80, 36, VictoryGold)
+	drawWrappedTextWithShadow(screen, "Time: "+clock, g.gameFont, ScreenWidth-240, 60, 200, 36, clockCol)
+	tally := "Score: " + itoa(run.Score()) + "   Correct: " + itoa(run.Correct) + "   Wrong: " + itoa(run.Wrong) +
+		" (-" + itoa(game.TimeAttackPenalty) + " each)"
+	drawWrappedTextWithShadow(screen, tally, g.confirmFont, 40, 100, ScreenWidth-80, 24, SmokeWhite)
+
+	if g.timeAttackFlash != "" && time.Since(g.timeAttackFlashTime) < 600*time.Millisecond {
+		drawWrappedTextWithShadow(screen, g.timeAttackFlash, g.gameFont, practiceX, 140, practiceW, 36, g.timeAttackFlashCol)
+	}
+	if g.currentQ >= len(g.quizQuestions) {
+		return
+	}
+	q := g.quizQuestions[g.currentQ]
+	y := 200
+	qLines := wrapText(g.gameFont, q.Question, practiceW)
+	drawWrappedTextWithShadow(screen, q.Question, g.gameFont, practiceX, y, practiceW, 36, SmokeWhite)
+	g.drawChoiceList(screen, q, y+len(qLines)*36+12, false)
+	drawWrappedTextWithShadow(screen, "(1-3 to answer, ESC to stop early)", g.confirmFont, 40, ScreenHeight-32, ScreenWidth-80, 24, SmokeWhite)
+}
+
+func (g *Game) updateTimeAttack(mouseJustPressed bool) {
+	if g.timeAttack.Over(time.Now()) || inpututil.IsKeyJustPressed(ebiten.KeyEscape) || g.currentQ >= len(g.quizQuestions) {
+		g.finishTimeAttack()
+		return
+	}
+	choice := g.pickedChoice(mouseJustPressed)
+	if choice < 0 {
+		return
+	}
+	q := g.quizQuestions[g.currentQ]
+	correct := choice == q.Answer
+	g.timeAttack.Answer(correct)
+	g.answerRecords = append(g.answerRecords, game.AnswerRecord{
+		QuestionID: game.QuestionID(q.Subject, q.Question),
+		Question:   q.Question,
+		Chosen:     q.Options[choice],
+		Correct:    correct,
+		Subject:    q.Subject,
+		Difficulty: g.timeAttack.Difficulty,
+	})
+	if correct {
+		g.timeAttackFlash, g.timeAttackFlashCol = "+"+itoa(game.TimeAttackPoints), VictoryGold
+	} else {
+		g.timeAttackFlash, g.timeAttackFlashCol = "-"+itoa(game.TimeAttackPenalty), AlertRed
+	}
+	g.timeAttackFlashTime = time.Now()
+	g.currentQ++
+	g.refillTimeAttackQuestions(g.timeAttack.Difficulty)
+}
+
+func (g *Game) drawTimeAttackResults(screen *ebiten.Image) {
+	run := g.timeAttack
+	lines := []string{
+		"Score: " + itoa(run.Score()),
+		"Correct: " + itoa(run.Correct) + "   Wrong: " + itoa(run.Wrong),
+	}
+	for i, line := range lines {
+		drawWrappedTextWithShadow(screen, line, g.gameFont, ScreenWidth/10, ScreenHeight/8+60+i*40, ScreenWidth*8/10, 36, SmokeWhite)
+	}
+	g.drawMenuButtons(screen, "Time Attack Results", []string{"Go Again", "Main Menu"}, nil)
+}
+
+func (g *Game) updateTimeAttackResults(mouseJustPressed bool) {
+	x, y := ebiten.CursorPosition()
+	g.hoveredMenu = hitRect(g.menuRects, x, y)
+	if !mouseJustPressed {
+		return
+	}
+	switch g.hoveredMenu {
+	case 0:
+		g.startTimeAttack(g.timeAttack.Difficulty)
+	case 1:
+		g.mode = game.ModeStandard
+		g.state = StateMenu
+	}
+}
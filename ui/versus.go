@@ -0,0 +1,273 @@
+package ui
+
+import (
+	"image"
+	"log"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/RALPH22222/Broadside/game"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// openVersus shows the two-player name entry; the signed-in captain is player 1.
+func (g *Game) openVersus() {
+	g.versusNames = [2]string{g.playerName, ""}
+	g.versusField = 0
+	if g.playerName != "" {
+		g.versusField = 1
+	}
+	g.versusMsg = ""
+	g.versusCaptain = -1
+	g.state = StateVersusNames
+}
+
+// versusNameRects returns the input boxes of both players
+func versusNameRects() [2]image.Rectangle {
+	x, w := (ScreenWidth-600)/2+60, 480
+	return [2]image.Rectangle{
+		image.Rect(x, 260, x+w, 308),
+		image.Rect(x, 380, x+w, 428),
+	}
+}
+
+// versusSeatRect is the toggle that puts a computer captain in player 2's seat
+func versusSeatRect() image.Rectangle {
+	x := (ScreenWidth-600)/2 + 60
+	return image.Rect(x, 438, x+480, 470)
+}
+
+func (g *Game) drawVersusNames(screen *ebiten.Image) {
+	drawWrappedTextWithShadow(screen, "Versus: two captains, one machine", g.gameFont, ScreenWidth/10, ScreenHeight/8, ScreenWidth*8/10, 36, VictoryGold)
+	x, y, w, h := (ScreenWidth-600)/2, 180, 600, 300
+	vector.DrawFilledRect(screen, float32(x), float32(y), float32(w), float32(h), GunmetalGray, true)
+	vector.StrokeRect(screen, float32(x), float32(y), float32(w), float32(h), 4, VictoryGold, true)
+	for i, rect := range versusNameRects() {
+		drawWrappedTextWithShadow(screen, "Player "+itoa(i+1)+" name:", g.gameFont, rect.Min.X, rect.Min.Y-16, rect.Dx(), 36, VictoryGold)
+		border := NavyBlue
+		if g.versusField == i {
+			border = OceanTeal
+		}
+		vector.DrawFilledRect(screen, float32(rect.Min.X), float32(rect.Min.Y), float32(rect.Dx()), float32(rect.Dy()), SmokeWhite, true)
+		vector.StrokeRect(screen, float32(rect.Min.X), float32(rect.Min.Y), float32(rect.Dx()), float32(rect.Dy()), 3, border, true)
+		name := g.versusNames[i]
+		if g.versusField == i && (time.Now().UnixNano()/500_000_000)%2 == 0 {
+			name += "|"
+		}
+		drawWrappedTextWithShadow(screen, name, g.gameFont, rect.Min.X+12, rect.Min.Y+36, rect.Dx()-24, 36, NavyBlue)
+	}
+	seat := versusSeatRect()
+	drawWrappedTextWithShadow(screen, "Player 2: "+seatLabel(g.versusCaptain)+" (click or F2)", g.confirmFont, seat.Min.X, seat.Min.Y+22, seat.Dx(), 24, OceanTeal)
+	drawWrappedTextWithShadow(screen, g.versusMsg, g.confirmFont, x, y+h+40, w, 24, AlertRed)
+	drawWrappedTextWithShadow(screen, "(TAB to switch, ENTER to continue, ESC to go back)", g.confirmFont, 40, ScreenHeight-32, ScreenWidth-80, 24, SmokeWhite)
+}
+
+func (g *Game) updateVersusNames(mouseJustPressed bool) {
+	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+		g.state = StateMultiplayerSelect
+		return
+	}
+	x, y := ebiten.CursorPosition()
+	if inpututil.IsKeyJustPressed(ebiten.KeyF2) || (mouseJustPressed && image.Pt(x, y).In(versusSeatRect())) {
+		g.versusCaptain = nextCaptain(g.versusCaptain)
+		g.versusNames[1] = ""
+		if g.versusCaptain >= 0 {
+			g.versusNames[1] = game.Personalities[g.versusCaptain].Name
+		}
+	}
+	if mouseJustPressed {
+		for i, rect := range versusNameRects() {
+			if image.Pt(x, y).In(rect) {
+				g.versusField = i
+			}
+		}
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyTab) {
+		g.versusField = 1 - g.versusField
+	}
+	// A computer captain's name comes with its personality
+	if g.versusCaptain >= 0 {
+		g.versusField = 0
+	}
+	name := &g.versusNames[g.versusField]
+	for _, r := range ebiten.AppendInputChars(nil) {
+		if len(*name) < 16 {
+			*name += string(r)
+		}
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyBackspace) && len(*name) > 0 {
+		_, size := utf8.DecodeLastRuneInString(*name)
+		*name = (*name)[:len(*name)-size]
+	}
+	if !inpututil.IsKeyJustPressed(ebiten.KeyEnter) && !inpututil.IsKeyJustPressed(ebiten.KeyNumpadEnter) {
+		return
+	}
+	if g.versusField == 0 && strings.TrimSpace(g.versusNames[1]) == "" {
+		g.versusField = 1
+		return
+	}
+	a, b := strings.TrimSpace(g.versusNames[0]), strings.TrimSpace(g.versusNames[1])
+	switch {
+	case a == "" || b == "":
+		g.versusMsg = "Both captains need a name."
+		return
+	case strings.EqualFold(a, b):
+		g.versusMsg = "Pick two different names."
+		return
+	}
+	g.versusNames = [2]string{a, b}
+	for i, name := range g.versusNames {
+		g.versusIDs[i] = 0
+		if i == 0 && name == g.playerName && g.userID > 0 {
+			g.versusIDs[i] = g.userID
+			continue
+		}
+		if i == 1 && g.versusCaptain >= 0 {
+			continue
+		}
+		id, err := game.SeatAccount(g.store, name)
+		if err != nil {
+			log.Printf("failed to save user: %v", err)
+			continue
+		}
+		g.versusIDs[i] = id
+	}
+	g.mode = game.ModeVersus
+	g.hoveredMenu = -1
+	g.state = StateSelectDifficulty
+}
+
+// startVersus starts a match of mixed-subject questions, taking turns from player 1.
+func (g *Game) startVersus(difficulty string) {
+	g.startCombat(nil, difficulty)
+	g.versus = game.NewVersusMatch(g.versusNames, g.versusIDs, g.level)
+	if g.versusCaptain >= 0 {
+		g.versus.Players[1].Seat = newCaptain(g.versusCaptain)
+	}
+	g.selectedDifficulty = difficulty
+	g.selectedSubject = "Versus"
+	questions := g.quiz.SelectQuestions(g.quiz.ListSubjects(), difficulty, 2*game.VersusRounds)
+	g.quizQuestions = make([]QuizQuestion, 0, len(questions))
+	for _, q := range questions {
+		g.quizQuestions = append(g.quizQuestions, QuizQuestion{
+			Question: q.Text,
+			Options:  q.Choices,
+			Answer:   q.AnswerIndex(),
+			Hint:     q.Hint,
+			Subject:  q.Subject,
+		})
+	}
+	g.bonusQIndex = -1
+	g.syncVersus()
+	g.state = StatePlaying
+}
+
+// syncVersus mirrors the match onto the battle fields drawShips reads:
+// player 1 sails the left ship, player 2 the right one.
+func (g *Game) syncVersus() {
+	a, b := g.versus.Players[0], g.versus.Players[1]
+	g.playerMaxHP, g.playerHP, g.playerShields = game.VersusMaxHP, a.HP, a.Shields
+	g.enemyMaxHP, g.enemyHP = game.VersusMaxHP, b.HP
+	g.combatOver = g.versus.Over()
+}
+
+// versusActionText describes the shot of the player who just answered.
+func (g *Game) versusActionText() string {
+	m := g.versus
+	shooter, target := m.Players[m.LastPlayer].Name, m.Players[1-m.LastPlayer].Name
+	switch {
+	case g.versusShieldsLost > 0:
+		return shooter + " knocks out one of " + target + "'s shields!"
+	case g.versusDamage > 0:
+		return shooter + " hits " + target + " for " + itoa(g.versusDamage) + "!"
+	}
+	return ""
+}
+
+// finishVersus records the match for both players and shows the results.
+func (g *Game) finishVersus() {
+	if g.versusIDs[0] > 0 && g.versusIDs[1] > 0 {
+		if err := g.store.InsertVersusMatch(g.versus, g.selectedDifficulty); err != nil {
+			log.Printf("failed to save versus match: %v", err)
+		}
+		if err := game.UpdateMatchRatings(g.store, g.versus); err != nil {
+			log.Printf("failed to save ratings: %v", err)
+		}
+	}
+	g.showFeedback = false
+	g.selectedAns = -1
+	g.hoveredMenu = -1
+	g.state = StateVersusResults
+}
+
+// drawVersusHUD draws both players' ships' status on either side and whose turn it is.
+func (g *Game) drawVersusHUD(screen *ebiten.Image, barY int) {
+	m := g.versus
+	for i, p := range m.Players {
+		x := 40
+		if i == 1 {
+			x = ScreenWidth - 340
+		}
+		nameCol := SmokeWhite
+		if m.Turn == i && !g.showFeedback {
+			nameCol = VictoryGold
+		}
+		drawWrappedTextWithShadow(screen, p.Name+": "+itoa(p.Score)+" pts", g.gameFont, x, barY, 300, 36, nameCol)
+		status := "HP " + itoa(p.HP) + "/" + itoa(game.VersusMaxHP) + "  Shields " + itoa(p.Shields)
+		drawWrappedTextWithShadow(screen, status, g.gameFont, x, barY+40, 300, 36, OceanTeal)
+	}
+	round := m.Players[m.Turn].Answered + 1
+	if round > game.VersusRounds {
+		round = game.VersusRounds
+	}
+	turn := m.Players[m.Turn].Name + "'s turn - round " + itoa(round) + "/" + itoa(game.VersusRounds)
+	drawWrappedTextWithShadow(screen, turn, g.confirmFont, ScreenWidth/2-150, barY+130, 300, 24, VictoryGold)
+}
+
+// drawVersusShipLabels names the ships and marks the one whose captain answers next.
+func (g *Game) drawVersusShipLabels(screen *ebiten.Image, shipTop int) {
+	for i, p := range g.versus.Players {
+		x := 60
+		if i == 1 {
+			x = ScreenWidth - 200 - 60
+		}
+		col := SmokeWhite
+		if g.versus.Turn == i {
+			col = VictoryGold
+			vector.DrawFilledCircle(screen, float32(x+100), float32(shipTop-44), 6, VictoryGold, true)
+		}
+		drawWrappedTextWithShadow(screen, p.Name, g.confirmFont, x, shipTop-12, 200, 24, col)
+	}
+}
+
+func (g *Game) drawVersusResults(screen *ebiten.Image) {
+	m := g.versus
+	headline := "It's a draw!"
+	if w := m.Winner(); w >= 0 {
+		headline = m.Players[w].Name + " wins!"
+	}
+	drawWrappedTextWithShadow(screen, headline, g.gameFont, ScreenWidth/10, ScreenHeight/8+60, ScreenWidth*8/10, 36, VictoryGold)
+	for i, p := range m.Players {
+		line := p.Name + ": " + itoa(p.Score) + " pts, " + itoa(p.Correct) + "/" + itoa(p.Answered) + " correct, hull " + itoa(p.HP)
+		drawWrappedTextWithShadow(screen, line, g.gameFont, ScreenWidth/10, ScreenHeight/8+110+i*40, ScreenWidth*8/10, 36, SmokeWhite)
+	}
+	g.drawButtonRow(screen, []string{"Rematch", "Main Menu"}, nil)
+}
+
+func (g *Game) updateVersusResults(mouseJustPressed bool) {
+	x, y := ebiten.CursorPosition()
+	g.hoveredMenu = hitRect(g.buttonRects, x, y)
+	if !mouseJustPressed {
+		return
+	}
+	switch g.hoveredMenu {
+	case 0:
+		g.startVersus(g.selectedDifficulty)
+	case 1:
+		g.mode = game.ModeStandard
+		g.state = StateMenu
+	}
+}